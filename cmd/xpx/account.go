@@ -0,0 +1,233 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+func runAccount(a *app, cmd *command, args []string) error {
+	if len(args) == 0 {
+		cmd.flags().Usage()
+		return errUsage
+	}
+
+	switch args[0] {
+	case "create":
+		return runAccountCreate(a, cmd, args[1:])
+	case "import":
+		return runAccountImport(a, cmd, args[1:])
+	case "info":
+		return runAccountInfo(a, args[1:])
+	case "list":
+		return runAccountList(a)
+	}
+
+	cmd.flags().Usage()
+	return errUsage
+}
+
+// generates new account and stores it in keystore
+func runAccountCreate(a *app, cmd *command, args []string) error {
+	flags := cmd.flags()
+	name := flags.String("name", a.config.Account, "name of account in keystore")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	account, err := client.NewAccount()
+	if err != nil {
+		return err
+	}
+
+	return a.storeAccount(*name, account)
+}
+
+// stores private key from XPX_PRIVATE_KEY or standard input in keystore
+// key is not accepted as argument, because arguments are visible to other users and kept in shell history
+func runAccountImport(a *app, cmd *command, args []string) error {
+	flags := cmd.flags()
+	name := flags.String("name", a.config.Account, "name of account in keystore")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	if flags.NArg() > 0 {
+		return errors.New("private key must not be passed as argument, set XPX_PRIVATE_KEY or pass it on standard input")
+	}
+
+	key, ok := os.LookupEnv("XPX_PRIVATE_KEY")
+	if !ok {
+		line, err := a.secret("private key: ")
+		if err != nil || line == "" {
+			return errors.New("private key is expected in XPX_PRIVATE_KEY or on standard input")
+		}
+
+		key = line
+	}
+
+	key = strings.TrimSpace(key)
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	account, err := client.NewAccountFromPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	return a.storeAccount(*name, account)
+}
+
+func (a *app) storeAccount(name string, account *sdk.Account) error {
+	ks, err := a.openKeystore()
+	if err != nil {
+		return err
+	}
+
+	if ks.entry(name) != nil {
+		return errAccountExists
+	}
+
+	password, err := a.newPassword()
+	if err != nil {
+		return err
+	}
+
+	if password == "" {
+		return errors.New("password must not be empty")
+	}
+
+	err = ks.add(name, account.Address.Address, account.PublicAccount.PublicKey, account.PrivateKey.String(), password)
+	if err != nil {
+		return err
+	}
+
+	a.printf("name:       %s\naddress:    %s\npublic key: %s\n", name, account.Address.Address, account.PublicAccount.PublicKey)
+	return nil
+}
+
+// prints info and names of account
+func runAccountInfo(a *app, args []string) error {
+	address, err := a.addressOf(firstArg(args))
+	if err != nil {
+		return err
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	info, err := client.Account.GetAccountInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	a.println(info)
+
+	names, err := client.Account.GetAccountNames(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range names {
+		a.println(n)
+	}
+
+	return nil
+}
+
+// prints accounts stored in keystore, private keys are not decrypted
+func runAccountList(a *app) error {
+	ks, err := a.openKeystore()
+	if err != nil {
+		return err
+	}
+
+	for _, e := range ks.Accounts {
+		a.printf("%-16s %s %s\n", e.Name, e.Address, e.PublicKey)
+	}
+
+	return nil
+}
+
+// returns multisig info of account, or graph of multisig accounts if -graph is passed
+func runMultisig(a *app, cmd *command, args []string) error {
+	flags := cmd.flags()
+	graph := flags.Bool("graph", false, "print graph of multisig accounts")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	address, err := a.addressOf(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	if *graph {
+		info, err := client.Account.GetMultisigAccountGraphInfo(ctx, address)
+		if err != nil {
+			return err
+		}
+
+		levels := make([]int, 0, len(info.MultisigAccounts))
+		for level := range info.MultisigAccounts {
+			levels = append(levels, int(level))
+		}
+
+		sort.Ints(levels)
+
+		for _, level := range levels {
+			a.printf("level %d:\n", level)
+
+			for _, acc := range info.MultisigAccounts[int32(level)] {
+				a.println(acc)
+			}
+		}
+
+		return nil
+	}
+
+	info, err := client.Account.GetMultisigAccountInfo(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	a.println(info)
+	return nil
+}
+
+// returns the first argument or empty string
+func firstArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	return args[0]
+}
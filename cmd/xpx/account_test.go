@@ -0,0 +1,38 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApp_newPassword(t *testing.T) {
+	if p, ok := os.LookupEnv("XPX_PASSWORD"); ok {
+		defer os.Setenv("XPX_PASSWORD", p)
+	}
+	assert.Nil(t, os.Unsetenv("XPX_PASSWORD"))
+
+	a := &app{in: bufio.NewReader(strings.NewReader("secret\nsecret\nsecret\nother\n")), terminal: -1}
+
+	password, err := a.newPassword()
+	assert.Nil(t, err)
+	assert.Equal(t, "secret", password)
+
+	_, err = a.newPassword()
+	assert.EqualError(t, err, "passwords do not match")
+}
+
+func TestRunAccountImport_KeyArgument(t *testing.T) {
+	a := &app{config: &cliConfig{}, in: bufio.NewReader(strings.NewReader("")), terminal: -1}
+
+	err := runAccountImport(a, commands[0], []string{testPrivateKey})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "must not be passed as argument")
+}
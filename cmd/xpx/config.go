@@ -0,0 +1,83 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const defaultAccount = "default"
+
+// cliConfig is read from JSON file, e.g.
+//
+//	{"nodes": ["http://127.0.0.1:3000"], "keystore": "/home/user/.xpx/keystore.json", "account": "default"}
+//
+// environment variables XPX_NODES, XPX_KEYSTORE and XPX_ACCOUNT override values of file
+type cliConfig struct {
+	Nodes    []string `json:"nodes"`
+	Keystore string   `json:"keystore"`
+	Account  string   `json:"account"`
+}
+
+// returns XPX_CONFIG or config.json in directory of xpx in home directory
+func defaultConfigPath() string {
+	if path, ok := os.LookupEnv("XPX_CONFIG"); ok {
+		return path
+	}
+
+	return filepath.Join(homeDir(), "config.json")
+}
+
+// returns directory of xpx files in home directory
+func homeDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".xpx"
+	}
+
+	return filepath.Join(home, ".xpx")
+}
+
+// returns config read from passed path, missing file is treated as empty config
+func loadCliConfig(path string) (*cliConfig, error) {
+	conf := &cliConfig{}
+
+	data, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, conf); err != nil {
+			return nil, fmt.Errorf("parsing config %s: %s", path, err)
+		}
+	}
+
+	if nodes, ok := os.LookupEnv("XPX_NODES"); ok {
+		conf.Nodes = splitList(nodes)
+	}
+
+	if ks, ok := os.LookupEnv("XPX_KEYSTORE"); ok {
+		conf.Keystore = ks
+	}
+
+	if account, ok := os.LookupEnv("XPX_ACCOUNT"); ok {
+		conf.Account = account
+	}
+
+	if conf.Keystore == "" {
+		conf.Keystore = filepath.Join(homeDir(), "keystore.json")
+	}
+
+	if conf.Account == "" {
+		conf.Account = defaultAccount
+	}
+
+	return conf, nil
+}
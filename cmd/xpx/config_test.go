@@ -0,0 +1,47 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCliConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "xpx")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	conf, err := loadCliConfig(filepath.Join(dir, "missing.json"))
+	assert.Nil(t, err)
+	assert.Empty(t, conf.Nodes)
+	assert.Equal(t, defaultAccount, conf.Account)
+	assert.NotEmpty(t, conf.Keystore)
+
+	path := filepath.Join(dir, "config.json")
+	err = ioutil.WriteFile(path, []byte(`{"nodes": ["http://127.0.0.1:3000"], "keystore": "/tmp/ks.json", "account": "alice"}`), 0600)
+	assert.Nil(t, err)
+
+	conf, err = loadCliConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, &cliConfig{Nodes: []string{"http://127.0.0.1:3000"}, Keystore: "/tmp/ks.json", Account: "alice"}, conf)
+
+	os.Setenv("XPX_NODES", "http://a:3000, http://b:3000")
+	defer os.Unsetenv("XPX_NODES")
+
+	conf, err = loadCliConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"http://a:3000", "http://b:3000"}, conf.Nodes)
+
+	err = ioutil.WriteFile(path, []byte(`{"nodes": `), 0600)
+	assert.Nil(t, err)
+
+	_, err = loadCliConfig(path)
+	assert.NotNil(t, err)
+}
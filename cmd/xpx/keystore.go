@@ -0,0 +1,214 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keystoreVersion = 1
+	scryptN         = 1 << 15
+	scryptR         = 8
+	scryptP         = 1
+	keyLength       = 32
+)
+
+var (
+	errWrongPassword   = errors.New("wrong password or corrupted keystore")
+	errAccountNotFound = errors.New("account is not found in keystore")
+	errAccountExists   = errors.New("account with this name already exists in keystore")
+)
+
+// keystore keeps private keys of accounts encrypted by AES-256-GCM with key derived from password by scrypt
+type keystore struct {
+	path     string
+	Version  int              `json:"version"`
+	Accounts []*keystoreEntry `json:"accounts"`
+}
+
+type keystoreEntry struct {
+	Name      string       `json:"name"`
+	Address   string       `json:"address"`
+	PublicKey string       `json:"publicKey"`
+	Crypto    *cryptoEntry `json:"crypto"`
+}
+
+type cryptoEntry struct {
+	Kdf        string `json:"kdf"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       string `json:"salt"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// returns keystore read from passed path, missing file is treated as empty keystore
+func loadKeystore(path string) (*keystore, error) {
+	ks := &keystore{path: path, Version: keystoreVersion}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ks, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, ks); err != nil {
+		return nil, fmt.Errorf("parsing keystore %s: %s", path, err)
+	}
+
+	if ks.Version != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", ks.Version)
+	}
+
+	return ks, nil
+}
+
+// returns entry of account with passed name or nil
+func (ks *keystore) entry(name string) *keystoreEntry {
+	for _, e := range ks.Accounts {
+		if e.Name == name {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// encrypts private key by password, adds it to keystore and saves keystore file
+func (ks *keystore) add(name, address, publicKey, privateKey, password string) error {
+	if ks.entry(name) != nil {
+		return errAccountExists
+	}
+
+	c, err := encryptKey(privateKey, password)
+	if err != nil {
+		return err
+	}
+
+	ks.Accounts = append(ks.Accounts, &keystoreEntry{
+		Name:      name,
+		Address:   address,
+		PublicKey: publicKey,
+		Crypto:    c,
+	})
+
+	return ks.save()
+}
+
+// returns decrypted private key of account with passed name
+func (ks *keystore) privateKey(name, password string) (string, error) {
+	e := ks.entry(name)
+	if e == nil {
+		return "", errAccountNotFound
+	}
+
+	return decryptKey(e.Crypto, password)
+}
+
+func (ks *keystore) save() error {
+	data, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ks.path), 0700); err != nil {
+		return err
+	}
+
+	tmp := ks.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, ks.path)
+}
+
+func encryptKey(privateKey, password string) (*cryptoEntry, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	c := &cryptoEntry{Kdf: "scrypt", N: scryptN, R: scryptR, P: scryptP, Salt: hex.EncodeToString(salt)}
+
+	aead, err := c.aead(password)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	c.Nonce = hex.EncodeToString(nonce)
+	c.Ciphertext = hex.EncodeToString(aead.Seal(nil, nonce, []byte(privateKey), nil))
+
+	return c, nil
+}
+
+func decryptKey(c *cryptoEntry, password string) (string, error) {
+	if c == nil || c.Kdf != "scrypt" {
+		return "", errWrongPassword
+	}
+
+	aead, err := c.aead(password)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := hex.DecodeString(c.Nonce)
+	if err != nil || len(nonce) != aead.NonceSize() {
+		return "", errWrongPassword
+	}
+
+	ciphertext, err := hex.DecodeString(c.Ciphertext)
+	if err != nil {
+		return "", errWrongPassword
+	}
+
+	key, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errWrongPassword
+	}
+
+	return string(key), nil
+}
+
+// returns AES-GCM cipher with key derived from password by parameters of entry
+func (c *cryptoEntry) aead(password string) (cipher.AEAD, error) {
+	salt, err := hex.DecodeString(c.Salt)
+	if err != nil {
+		return nil, errWrongPassword
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, c.N, c.R, c.P, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
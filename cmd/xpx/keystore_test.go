@@ -0,0 +1,53 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPrivateKey = "A97B139EB641BCC841A610231870925EB301BA680D07BBCF9AEE83FAA5E9FB43"
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "xpx")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "nested", "keystore.json")
+
+	ks, err := loadKeystore(path)
+	assert.Nil(t, err)
+	assert.Empty(t, ks.Accounts)
+
+	assert.Nil(t, ks.add("alice", "VC4A3Z6QJOVGZBOZ3IRAYEEGPWOM3XMSDAN5JNM3", "pub", testPrivateKey, "secret"))
+	assert.Equal(t, errAccountExists, ks.add("alice", "", "", testPrivateKey, "secret"))
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), testPrivateKey)
+
+	loaded, err := loadKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "VC4A3Z6QJOVGZBOZ3IRAYEEGPWOM3XMSDAN5JNM3", loaded.entry("alice").Address)
+
+	key, err := loaded.privateKey("alice", "secret")
+	assert.Nil(t, err)
+	assert.Equal(t, testPrivateKey, key)
+
+	_, err = loaded.privateKey("alice", "wrong")
+	assert.Equal(t, errWrongPassword, err)
+
+	_, err = loaded.privateKey("bob", "secret")
+	assert.Equal(t, errAccountNotFound, err)
+}
@@ -0,0 +1,352 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Command xpx performs day-to-day operations with accounts, transfers and transactions on ProximaX Sirius chain.
+//
+// Usage:
+//
+//	xpx [-config path] [-node url,...] [-account name] [-timeout duration] <command> [arguments]
+//
+// Node URLs, keystore path and default account are read from config file, see cliConfig.
+// Private keys are stored in keystore encrypted by password, which is read from XPX_PASSWORD or standard input.
+// Passwords and private keys are not echoed if standard input is a terminal.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket"
+)
+
+var errUsage = errors.New("invalid usage")
+
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(a *app, cmd *command, args []string) error
+}
+
+var commands = []*command{
+	{"account", "create|import|info|list [flags]", "manages accounts in keystore and prints account info", runAccount},
+	{"balance", "[address|name]", "prints mosaics held by account", runBalance},
+	{"transfer", "-to address|@namespace -mosaic id:amount [-message text [-encrypt]] [-wait]", "announces transfer transaction", runTransfer},
+	{"multisig", "[-graph] [address|name]", "prints multisig info of account", runMultisig},
+	{"namespace", "name|id", "prints namespace info and alias", runNamespace},
+	{"mosaic", "id|namespace", "prints mosaic info and names", runMosaic},
+	{"tx", "status|wait hash", "prints status of transaction or waits until it is confirmed", runTx},
+	{"block", "[-txs] [height]", "prints block, the last one if height is omitted", runBlock},
+	{"watch", "[-topics block,confirmed,unconfirmed,status,partial,cosignature] [address|name]", "prints live websocket events", runWatch},
+}
+
+// app keeps global options and lazily created clients, which are shared by commands
+type app struct {
+	ctx     context.Context
+	config  *cliConfig
+	timeout time.Duration
+	in      *bufio.Reader
+	out     io.Writer
+	// file descriptor of standard input if it is a terminal, otherwise -1
+	terminal int
+
+	sdkConfig *sdk.Config
+	client    *sdk.Client
+	keystore  *keystore
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		cancel()
+	}()
+
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout)
+	cancel()
+
+	if err == errUsage {
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "xpx: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, in io.Reader, out io.Writer) error {
+	flags := flag.NewFlagSet("xpx", flag.ContinueOnError)
+	flags.Usage = func() { usage(flags) }
+
+	configPath := flags.String("config", defaultConfigPath(), "path of config file")
+	nodes := flags.String("node", "", "comma separated URLs of REST servers, overrides config")
+	account := flags.String("account", "", "name of keystore account, overrides config")
+	timeout := flags.Duration("timeout", 30*time.Second, "timeout of REST calls")
+
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	conf, err := loadCliConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if *nodes != "" {
+		conf.Nodes = splitList(*nodes)
+	}
+
+	if *account != "" {
+		conf.Account = *account
+	}
+
+	a := &app{
+		ctx:      ctx,
+		config:   conf,
+		timeout:  *timeout,
+		in:       bufio.NewReader(in),
+		out:      out,
+		terminal: -1,
+	}
+
+	if f, ok := in.(*os.File); ok && terminal.IsTerminal(int(f.Fd())) {
+		a.terminal = int(f.Fd())
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == flags.Arg(0) {
+			return cmd.run(a, cmd, flags.Args()[1:])
+		}
+	}
+
+	flags.Usage()
+	return errUsage
+}
+
+func usage(flags *flag.FlagSet) {
+	w := flags.Output()
+	fmt.Fprintf(w, "usage: xpx [flags] <command> [arguments]\n\ncommands:\n")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n  %-10s   %s\n", cmd.name, cmd.args, "", cmd.summary)
+	}
+
+	fmt.Fprintf(w, "\nflags:\n")
+	flags.PrintDefaults()
+}
+
+// returns flag set of subcommand, which prints usage of command on error
+func (cmd *command) flags() *flag.FlagSet {
+	flags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: xpx %s %s\n", cmd.name, cmd.args)
+		flags.PrintDefaults()
+	}
+
+	return flags
+}
+
+// returns context of single REST call limited by -timeout
+func (a *app) callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(a.ctx, a.timeout)
+}
+
+// returns REST client, which is created on the first call
+// sdk.NewConfig requests network type and generation hash from the first available node
+func (a *app) sdkClient() (*sdk.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	if len(a.config.Nodes) == 0 {
+		return nil, errors.New("no node URLs, set them in config, XPX_NODES or -node")
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	conf, err := sdk.NewConfig(ctx, a.config.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	a.sdkConfig = conf
+	a.client = sdk.NewClient(nil, conf)
+
+	return a.client, nil
+}
+
+// returns websocket client connected to the same node as REST client
+func (a *app) wsClient() (websocket.CatapultClient, error) {
+	if _, err := a.sdkClient(); err != nil {
+		return nil, err
+	}
+
+	return websocket.NewClient(a.ctx, a.sdkConfig)
+}
+
+// returns keystore from configured path, which is loaded on the first call
+func (a *app) openKeystore() (*keystore, error) {
+	if a.keystore != nil {
+		return a.keystore, nil
+	}
+
+	ks, err := loadKeystore(a.config.Keystore)
+	if err != nil {
+		return nil, err
+	}
+
+	a.keystore = ks
+	return ks, nil
+}
+
+// returns password from XPX_PASSWORD or from the next line of standard input
+func (a *app) password(prompt string) (string, error) {
+	if p, ok := os.LookupEnv("XPX_PASSWORD"); ok {
+		return p, nil
+	}
+
+	p, err := a.secret(prompt)
+	if err != nil {
+		return "", fmt.Errorf("reading password: %s", err)
+	}
+
+	return p, nil
+}
+
+// returns password of new account, which is asked twice unless it is taken from XPX_PASSWORD
+func (a *app) newPassword() (string, error) {
+	if p, ok := os.LookupEnv("XPX_PASSWORD"); ok {
+		return p, nil
+	}
+
+	p, err := a.password("new password: ")
+	if err != nil {
+		return "", err
+	}
+
+	confirmation, err := a.password("repeat password: ")
+	if err != nil {
+		return "", err
+	}
+
+	if p != confirmation {
+		return "", errors.New("passwords do not match")
+	}
+
+	return p, nil
+}
+
+// prompts on standard error and returns the next line of standard input, which is not echoed if it is a terminal
+func (a *app) secret(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+
+	if a.terminal >= 0 {
+		b, err := terminal.ReadPassword(a.terminal)
+		fmt.Fprintln(os.Stderr)
+
+		return string(b), err
+	}
+
+	line, err := a.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// returns account, which signs transactions
+// XPX_PRIVATE_KEY is used if it is set, otherwise configured account is decrypted from keystore
+func (a *app) signer() (*sdk.Account, error) {
+	client, err := a.sdkClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := os.LookupEnv("XPX_PRIVATE_KEY"); ok {
+		return client.NewAccountFromPrivateKey(key)
+	}
+
+	ks, err := a.openKeystore()
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := a.password(fmt.Sprintf("password of %q: ", a.config.Account))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ks.privateKey(a.config.Account, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewAccountFromPrivateKey(key)
+}
+
+// returns address of passed argument, which is either address or name of keystore account
+// address of configured account is returned if argument is empty
+func (a *app) addressOf(arg string) (*sdk.Address, error) {
+	if arg == "" {
+		arg = a.config.Account
+	}
+
+	ks, err := a.openKeystore()
+	if err != nil {
+		return nil, err
+	}
+
+	if entry := ks.entry(arg); entry != nil {
+		return sdk.NewAddressFromRaw(entry.Address)
+	}
+
+	address, err := parseAddress(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither address nor name of keystore account", arg)
+	}
+
+	return address, nil
+}
+
+func splitList(s string) []string {
+	var items []string
+
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func (a *app) println(v ...interface{}) {
+	fmt.Fprintln(a.out, v...)
+}
+
+func (a *app) printf(format string, v ...interface{}) {
+	fmt.Fprintf(a.out, format, v...)
+}
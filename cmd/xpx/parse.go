@@ -0,0 +1,91 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+var errInvalidAmount = errors.New("invalid amount")
+
+// returns address parsed from raw or pretty form, e.g. "VC4A3Z-6QJOVG-..."
+func parseAddress(s string) (*sdk.Address, error) {
+	return sdk.NewAddressFromRaw(strings.ToUpper(strings.Replace(s, "-", "", -1)))
+}
+
+// returns MosaicId for 16 hex digits and NamespaceId of namespace alias for other values, e.g. "prx.xpx"
+func parseAssetId(s string) (sdk.AssetId, error) {
+	if id, ok := parseHexId(s); ok {
+		return sdk.NewMosaicId(id)
+	}
+
+	return sdk.NewNamespaceIdFromName(s)
+}
+
+func parseHexId(s string) (uint64, bool) {
+	if len(s) != 16 {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(s, 16, 64)
+	return id, err == nil
+}
+
+// returns amount in the smallest units of mosaic with passed divisibility
+// Example: parseAmount("1.5", 6) => 1500000
+func parseAmount(s string, divisibility uint8) (sdk.Amount, error) {
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+
+	if whole == "" && fraction == "" || len(fraction) > int(divisibility) {
+		return 0, errInvalidAmount
+	}
+
+	if strings.TrimLeft(whole+fraction, "0123456789") != "" {
+		return 0, errInvalidAmount
+	}
+
+	digits := whole + fraction + strings.Repeat("0", int(divisibility)-len(fraction))
+	if digits = strings.TrimLeft(digits, "0"); digits == "" {
+		return 0, nil
+	}
+
+	amount, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || amount > math.MaxInt64 {
+		return 0, errInvalidAmount
+	}
+
+	return sdk.Amount(amount), nil
+}
+
+// returns asset and amount from "asset:amount", e.g. "prx.xpx:1.5"
+func splitMosaic(s string) (string, string, error) {
+	i := strings.LastIndexByte(s, ':')
+	if i <= 0 || i == len(s)-1 {
+		return "", "", fmt.Errorf("mosaic %q is not in form id:amount", s)
+	}
+
+	return s[:i], s[i+1:], nil
+}
+
+// mosaicFlags collects values of repeated -mosaic flag
+type mosaicFlags []string
+
+func (m *mosaicFlags) String() string {
+	return strings.Join(*m, ",")
+}
+
+func (m *mosaicFlags) Set(s string) error {
+	*m = append(*m, s)
+	return nil
+}
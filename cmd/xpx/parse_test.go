@@ -0,0 +1,70 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value        string
+		divisibility uint8
+		amount       sdk.Amount
+		err          error
+	}{
+		{"1.5", 6, 1500000, nil},
+		{"1", 6, 1000000, nil},
+		{".25", 2, 25, nil},
+		{"0.000001", 6, 1, nil},
+		{"000", 0, 0, nil},
+		{"15", 0, 15, nil},
+		{"1.5", 0, 0, errInvalidAmount},
+		{"1.0000001", 6, 0, errInvalidAmount},
+		{"-1", 6, 0, errInvalidAmount},
+		{"1e6", 0, 0, errInvalidAmount},
+		{"", 6, 0, errInvalidAmount},
+		{".", 6, 0, errInvalidAmount},
+		{"9223372036854775808", 0, 0, errInvalidAmount},
+	}
+
+	for _, test := range tests {
+		amount, err := parseAmount(test.value, test.divisibility)
+		assert.Equal(t, test.err, err, test.value)
+		assert.Equal(t, test.amount, amount, test.value)
+	}
+}
+
+func TestSplitMosaic(t *testing.T) {
+	asset, amount, err := splitMosaic("prx.xpx:1.5")
+	assert.Nil(t, err)
+	assert.Equal(t, "prx.xpx", asset)
+	assert.Equal(t, "1.5", amount)
+
+	for _, value := range []string{"prx.xpx", ":1", "prx.xpx:"} {
+		_, _, err = splitMosaic(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestParseAssetId(t *testing.T) {
+	assetId, err := parseAssetId("0dc67fbe1cad29e3")
+	assert.Nil(t, err)
+	assert.IsType(t, &sdk.MosaicId{}, assetId)
+
+	assetId, err = parseAssetId("prx.xpx")
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.XpxNamespaceId, assetId)
+}
+
+func TestParseAddress(t *testing.T) {
+	address, err := parseAddress("vc4a3z-6qjovg-zboz3i-rayeeg-pwom3x-msdan5-jnm3")
+	assert.Nil(t, err)
+	assert.Equal(t, "VC4A3Z6QJOVGZBOZ3IRAYEEGPWOM3XMSDAN5JNM3", address.Address)
+}
@@ -0,0 +1,172 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+// prints mosaics held by account with resolved names and relative amounts
+func runBalance(a *app, _ *command, args []string) error {
+	address, err := a.addressOf(firstArg(args))
+	if err != nil {
+		return err
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	portfolio, err := client.Account.GetAccountPortfolio(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range portfolio.Holdings {
+		name := strings.Join(h.Names, ",")
+		if name == "" {
+			name = h.AssetId.String()
+		}
+
+		a.printf("%-24s %s\n", name, h.RelativeAmount())
+	}
+
+	return nil
+}
+
+// prints namespace info, which includes alias of namespace
+func runNamespace(a *app, cmd *command, args []string) error {
+	if len(args) != 1 {
+		cmd.flags().Usage()
+		return errUsage
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	var info *sdk.NamespaceInfo
+	if id, ok := parseHexId(args[0]); ok {
+		nsId, err := sdk.NewNamespaceId(id)
+		if err != nil {
+			return err
+		}
+
+		info, err = client.Namespace.GetNamespaceInfo(ctx, nsId)
+	} else {
+		info, err = client.Namespace.GetNamespaceInfoByName(ctx, args[0])
+	}
+
+	if err != nil {
+		return err
+	}
+
+	a.println(info)
+	return nil
+}
+
+// prints mosaic info and namespace names linked to mosaic
+func runMosaic(a *app, cmd *command, args []string) error {
+	if len(args) != 1 {
+		cmd.flags().Usage()
+		return errUsage
+	}
+
+	assetId, err := parseAssetId(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	info, err := client.Resolve.GetMosaicInfoByAssetId(ctx, assetId)
+	if err != nil {
+		return err
+	}
+
+	a.println(info)
+
+	names, err := client.Mosaic.GetMosaicsNames(ctx, info.MosaicId)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range names {
+		a.println(n)
+	}
+
+	return nil
+}
+
+// prints block at passed height or the last block, and its transactions if -txs is passed
+func runBlock(a *app, cmd *command, args []string) error {
+	flags := cmd.flags()
+	txs := flags.Bool("txs", false, "print transactions of block")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	var height sdk.Height
+	if flags.NArg() > 0 {
+		h, err := strconv.ParseUint(flags.Arg(0), 10, 63)
+		if err != nil {
+			return err
+		}
+
+		height = sdk.Height(h)
+	} else {
+		height, err = client.Blockchain.GetBlockchainHeight(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	block, err := client.Blockchain.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	a.println(block)
+
+	if !*txs {
+		return nil
+	}
+
+	transactions, err := client.Blockchain.GetBlockTransactions(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range transactions {
+		a.println(tx)
+	}
+
+	return nil
+}
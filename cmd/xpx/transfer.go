@@ -0,0 +1,160 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+var errUnknownPublicKey = errors.New("public key of recipient is unknown, it is revealed by the first outgoing transaction of account")
+
+// signs and announces transfer transaction, amounts of mosaics are relative unless -absolute is passed
+func runTransfer(a *app, cmd *command, args []string) error {
+	var mosaics mosaicFlags
+
+	flags := cmd.flags()
+	to := flags.String("to", "", "address or name of keystore account of recipient, or namespace alias prefixed by @")
+	flags.Var(&mosaics, "mosaic", "mosaic id or namespace and amount separated by colon, e.g. prx.xpx:1.5, can be repeated")
+	message := flags.String("message", "", "message of transfer")
+	encrypt := flags.Bool("encrypt", false, "encrypt message by public key of recipient")
+	absolute := flags.Bool("absolute", false, "amounts are in the smallest units of mosaics")
+	deadline := flags.Duration("deadline", time.Hour, "deadline of transaction")
+	wait := flags.Bool("wait", false, "wait until transaction is confirmed")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	if *to == "" || flags.NArg() != 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	account, err := a.signer()
+	if err != nil {
+		return err
+	}
+
+	client := a.client
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	txMosaics := make([]*sdk.Mosaic, 0, len(mosaics))
+	for _, m := range mosaics {
+		asset, amount, err := splitMosaic(m)
+		if err != nil {
+			return err
+		}
+
+		assetId, err := parseAssetId(asset)
+		if err != nil {
+			return err
+		}
+
+		var divisibility uint8
+		if !*absolute {
+			info, err := client.Resolve.GetMosaicInfoByAssetId(ctx, assetId)
+			if err != nil {
+				return err
+			}
+
+			divisibility = info.Properties.Divisibility
+		}
+
+		value, err := parseAmount(amount, divisibility)
+		if err != nil {
+			return err
+		}
+
+		mosaic, err := sdk.NewMosaic(assetId, value)
+		if err != nil {
+			return err
+		}
+
+		txMosaics = append(txMosaics, mosaic)
+	}
+
+	var recipient *sdk.Address
+	var alias *sdk.NamespaceId
+
+	if strings.HasPrefix(*to, "@") {
+		alias, err = sdk.NewNamespaceIdFromName(strings.TrimPrefix(*to, "@"))
+		if err != nil {
+			return err
+		}
+
+		if *encrypt {
+			recipient, err = client.Namespace.GetLinkedAddress(ctx, alias)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		recipient, err = a.addressOf(*to)
+		if err != nil {
+			return err
+		}
+	}
+
+	var msg sdk.Message = sdk.NewPlainMessage(*message)
+	if *encrypt {
+		info, err := client.Account.GetAccountInfo(ctx, recipient)
+		if err != nil {
+			return err
+		}
+
+		if strings.Trim(info.PublicKey, "0") == "" {
+			return errUnknownPublicKey
+		}
+
+		publicAccount, err := client.NewAccountFromPublicKey(info.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		msg, err = account.EncryptMessage(*message, publicAccount)
+		if err != nil {
+			return err
+		}
+	}
+
+	var tx *sdk.TransferTransaction
+	if alias != nil {
+		tx, err = client.NewTransferTransactionWithNamespace(sdk.NewDeadline(*deadline), alias, txMosaics, msg)
+	} else {
+		tx, err = client.NewTransferTransaction(sdk.NewDeadline(*deadline), recipient, txMosaics, msg)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	signed, err := account.Sign(tx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Transaction.Announce(ctx, signed); err != nil {
+		return err
+	}
+
+	a.printf("announced %s\n", signed.Hash)
+
+	if !*wait {
+		return nil
+	}
+
+	status, err := a.waitConfirmed(signed.Hash, *deadline, 5*time.Second)
+	if err != nil {
+		return err
+	}
+
+	a.println(status)
+	return nil
+}
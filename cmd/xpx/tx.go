@@ -0,0 +1,130 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+const confirmedGroup = "confirmed"
+
+var errWaitTimeout = errors.New("transaction is not confirmed in time")
+
+func runTx(a *app, cmd *command, args []string) error {
+	if len(args) == 0 {
+		cmd.flags().Usage()
+		return errUsage
+	}
+
+	switch args[0] {
+	case "status":
+		return runTxStatus(a, cmd, args[1:])
+	case "wait":
+		return runTxWait(a, cmd, args[1:])
+	}
+
+	cmd.flags().Usage()
+	return errUsage
+}
+
+// prints status of transaction
+func runTxStatus(a *app, cmd *command, args []string) error {
+	if len(args) != 1 {
+		cmd.flags().Usage()
+		return errUsage
+	}
+
+	hash, err := sdk.StringToHash(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := a.sdkClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := a.callContext()
+	defer cancel()
+
+	status, err := client.Transaction.GetTransactionStatus(ctx, hash.String())
+	if err != nil {
+		return err
+	}
+
+	a.println(status)
+	return nil
+}
+
+// waits until transaction is confirmed, returns validation error if transaction failed
+func runTxWait(a *app, cmd *command, args []string) error {
+	flags := cmd.flags()
+	timeout := flags.Duration("for", 5*time.Minute, "maximal time of waiting")
+	interval := flags.Duration("interval", 5*time.Second, "interval of status polling")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errUsage
+	}
+
+	hash, err := sdk.StringToHash(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if _, err := a.sdkClient(); err != nil {
+		return err
+	}
+
+	status, err := a.waitConfirmed(hash, *timeout, *interval)
+	if err != nil {
+		return err
+	}
+
+	a.println(status)
+	return nil
+}
+
+// polls status of transaction until it is confirmed or failed
+// unknown transaction is polled further, because node can announce it to others with delay
+func (a *app) waitConfirmed(hash *sdk.Hash, timeout, interval time.Duration) (*sdk.TransactionStatus, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := a.client.Transaction.GetTransactionStatus(ctx, hash.String())
+		switch {
+		case err == nil && status.Group == confirmedGroup:
+			return status, nil
+		case err == nil:
+			if err := status.Err(); err != nil {
+				return nil, err
+			}
+		case errors.Is(err, sdk.ErrResourceNotFound):
+		case ctx.Err() == nil:
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			if a.ctx.Err() != nil {
+				return nil, a.ctx.Err()
+			}
+
+			return nil, errWaitTimeout
+		case <-ticker.C:
+		}
+	}
+}
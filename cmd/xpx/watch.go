@@ -0,0 +1,95 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket"
+)
+
+var watchTopics = []string{"block", "confirmed", "unconfirmed", "status", "partial", "cosignature"}
+
+// prints events of websocket topics until interrupted
+func runWatch(a *app, cmd *command, args []string) error {
+	flags := cmd.flags()
+	topics := flags.String("topics", "block,confirmed,unconfirmed,status", "comma separated topics, one of block, confirmed, unconfirmed, status, partial, cosignature")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	ws, err := a.wsClient()
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	var address *sdk.Address
+	subscribe := func(topic string) (interface{}, error) {
+		if topic != "block" && address == nil {
+			if address, err = a.addressOf(flags.Arg(0)); err != nil {
+				return nil, err
+			}
+		}
+
+		switch topic {
+		case "block":
+			return ws.SubscribeBlock(a.ctx, nil)
+		case "confirmed":
+			return ws.SubscribeConfirmedAdded(a.ctx, address, nil)
+		case "unconfirmed":
+			return ws.SubscribeUnconfirmedAdded(a.ctx, address, nil)
+		case "status":
+			return ws.SubscribeStatus(a.ctx, address, nil)
+		case "partial":
+			return ws.SubscribePartialAdded(a.ctx, address, nil)
+		case "cosignature":
+			return ws.SubscribeCosignature(a.ctx, address, nil)
+		}
+
+		return nil, fmt.Errorf("unknown topic %q, expected one of %v", topic, watchTopics)
+	}
+
+	names := splitList(*topics)
+	cases := make([]reflect.SelectCase, 0, len(names))
+
+	for _, topic := range names {
+		ch, err := subscribe(topic)
+		if err != nil {
+			return err
+		}
+
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)})
+	}
+
+	ws.SetReconnectionOptions(&websocket.ReconnectionOptions{
+		StateHandler: func(state websocket.ConnectionState, err error) {
+			if err != nil {
+				a.printf("[connection] %s: %s\n", state, err)
+				return
+			}
+
+			a.printf("[connection] %s\n", state)
+		},
+	})
+
+	go ws.Listen()
+
+	// channels are closed when context is canceled by interrupt
+	for open := len(cases); open > 0; {
+		i, v, ok := reflect.Select(cases)
+		if !ok {
+			cases[i].Chan = reflect.Value{}
+			open--
+			continue
+		}
+
+		a.printf("[%s] %v\n", names[i], v.Interface())
+	}
+
+	return nil
+}
@@ -14,4 +14,5 @@ require (
 	github.com/proximax-storage/go-xpx-utils v0.0.0-20190604083640-90d06ff8a19f
 	github.com/stretchr/testify v1.3.0
 	golang.org/x/crypto v0.0.0-20190513172903-22d7a77e9e5f
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,45 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+var (
+	_ sdk.ClientApi      = (*ClientApi)(nil)
+	_ sdk.AccountApi     = (*AccountApi)(nil)
+	_ sdk.BlockchainApi  = (*BlockchainApi)(nil)
+	_ sdk.MosaicApi      = (*MosaicApi)(nil)
+	_ sdk.NamespaceApi   = (*NamespaceApi)(nil)
+	_ sdk.NetworkApi     = (*NetworkApi)(nil)
+	_ sdk.TransactionApi = (*TransactionApi)(nil)
+	_ sdk.ResolverApi    = (*ResolverApi)(nil)
+	_ sdk.ContractApi    = (*ContractApi)(nil)
+	_ sdk.MetadataApi    = (*MetadataApi)(nil)
+	_ sdk.LockApi        = (*LockApi)(nil)
+)
+
+func TestClientApi_ServiceMock(t *testing.T) {
+	blockchain := &BlockchainApi{}
+	blockchain.On("GetBlockchainHeight", mock.Anything).Return(sdk.Height(42), nil)
+
+	client := &ClientApi{}
+	client.On("BlockchainApi").Return(blockchain)
+
+	var api sdk.ClientApi = client
+	height, err := api.BlockchainApi().GetBlockchainHeight(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.Height(42), height)
+	client.AssertExpectations(t)
+	blockchain.AssertExpectations(t)
+}
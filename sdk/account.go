@@ -165,6 +165,22 @@ func (a *AccountService) GetMultisigAccountGraphInfo(ctx context.Context, addres
 	return dto.toStruct(a.client.config.NetworkType)
 }
 
+// returns MultisigNode tree of multisig account for passed Address built from it's multisig graph
+func (a *AccountService) GetMultisigAccountTree(ctx context.Context, address *Address) (*MultisigNode, error) {
+	graph, err := a.GetMultisigAccountGraphInfo(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range graph.MultisigAccounts[0] {
+		if info.Account.Address != nil && info.Account.Address.Address == address.Address {
+			return NewMultisigTree(graph, info.Account.PublicKey)
+		}
+	}
+
+	return nil, ErrAccountNotInMultisigGraph
+}
+
 // GetAccountNames Returns friendly names for accounts.
 // post @/account/names
 func (ref *AccountService) GetAccountNames(ctx context.Context, addr ...*Address) ([]*AccountName, error) {
@@ -247,3 +263,76 @@ func (a *AccountService) findTransactions(ctx context.Context, account *PublicAc
 
 	return MapTransactions(&b)
 }
+
+// returns AccountPortfolio for passed Address, where every held mosaic is resolved to it's names and divisibility
+func (a *AccountService) GetAccountPortfolio(ctx context.Context, address *Address) (*AccountPortfolio, error) {
+	if address == nil {
+		return nil, ErrNilAddress
+	}
+
+	portfolios, err := a.GetAccountsPortfolios(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(portfolios) == 0 {
+		return nil, ErrResourceNotFound
+	}
+
+	return portfolios[0], nil
+}
+
+// returns AccountPortfolio's for passed Address's
+// mosaic infos and names of all held mosaics are requested by one batch for all accounts
+func (a *AccountService) GetAccountsPortfolios(ctx context.Context, addresses ...*Address) ([]*AccountPortfolio, error) {
+	accountInfos, err := a.GetAccountsInfo(ctx, addresses...)
+	if err != nil {
+		return nil, err
+	}
+
+	mscIds := heldMosaicIds(accountInfos)
+
+	mscInfos := make(map[uint64]*MosaicInfo, len(mscIds))
+	mscNames := make(map[uint64][]string, len(mscIds))
+
+	if len(mscIds) > 0 {
+		infos, err := a.client.Mosaic.GetMosaicInfos(ctx, mscIds)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range infos {
+			mscInfos[info.MosaicId.Id()] = info
+		}
+
+		names, err := a.client.Mosaic.GetMosaicsNames(ctx, mscIds...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			mscNames[name.MosaicId.Id()] = name.Names
+		}
+	}
+
+	portfolios := make([]*AccountPortfolio, len(accountInfos))
+
+	for i, accountInfo := range accountInfos {
+		holdings := make([]*MosaicHolding, len(accountInfo.Mosaics))
+
+		for j, mosaic := range accountInfo.Mosaics {
+			holdings[j] = &MosaicHolding{
+				Mosaic:     mosaic,
+				MosaicInfo: mscInfos[mosaic.AssetId.Id()],
+				Names:      mscNames[mosaic.AssetId.Id()],
+			}
+		}
+
+		portfolios[i] = &AccountPortfolio{
+			Address:  accountInfo.Address,
+			Holdings: holdings,
+		}
+	}
+
+	return portfolios, nil
+}
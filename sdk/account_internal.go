@@ -390,3 +390,27 @@ func (m *accountNamesDTOs) toStruct() ([]*AccountName, error) {
 
 	return accNames, nil
 }
+
+// returns unique MosaicId's held by passed accounts
+func heldMosaicIds(accountInfos []*AccountInfo) []*MosaicId {
+	ids := make([]*MosaicId, 0)
+	seen := make(map[uint64]struct{})
+
+	for _, accountInfo := range accountInfos {
+		for _, mosaic := range accountInfo.Mosaics {
+			mscId, ok := mosaic.AssetId.(*MosaicId)
+			if !ok {
+				continue
+			}
+
+			if _, ok := seen[mscId.Id()]; ok {
+				continue
+			}
+
+			seen[mscId.Id()] = struct{}{}
+			ids = append(ids, mscId)
+		}
+	}
+
+	return ids
+}
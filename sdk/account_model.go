@@ -181,6 +181,87 @@ func (a *AccountName) String() string {
 	)
 }
 
+// MosaicHolding is a Mosaic held by account with resolved MosaicInfo and names of namespaces aliased to it
+type MosaicHolding struct {
+	*Mosaic
+	MosaicInfo *MosaicInfo
+	Names      []string
+}
+
+// returns divisibility of held mosaic or zero if MosaicInfo was not resolved
+func (h *MosaicHolding) Divisibility() uint8 {
+	if h.MosaicInfo == nil || h.MosaicInfo.Properties == nil {
+		return 0
+	}
+
+	return h.MosaicInfo.Properties.Divisibility
+}
+
+// returns amount of held mosaic formatted with mosaic divisibility
+// Example: amount 1500000 of mosaic with divisibility 6 => "1.500000"
+func (h *MosaicHolding) RelativeAmount() string {
+	return FormatAmount(h.Amount, h.Divisibility())
+}
+
+// returns true if passed namespace name like "prx.xpx" is aliased to held mosaic
+func (h *MosaicHolding) HasName(name string) bool {
+	for _, n := range h.Names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *MosaicHolding) String() string {
+	return str.StructToString(
+		"MosaicHolding",
+		str.NewField("Mosaic", str.StringPattern, h.Mosaic),
+		str.NewField("MosaicInfo", str.StringPattern, h.MosaicInfo),
+		str.NewField("Names", str.StringPattern, h.Names),
+	)
+}
+
+type AccountPortfolio struct {
+	Address  *Address
+	Holdings []*MosaicHolding
+}
+
+// returns MosaicHolding for passed namespace name like "prx.xpx" or nil if account doesn't hold such mosaic
+func (p *AccountPortfolio) HoldingByName(name string) *MosaicHolding {
+	for _, h := range p.Holdings {
+		if h.HasName(name) {
+			return h
+		}
+	}
+
+	return nil
+}
+
+// returns MosaicHolding for passed AssetId or nil if account doesn't hold such mosaic
+func (p *AccountPortfolio) HoldingByAssetId(assetId AssetId) *MosaicHolding {
+	if assetId == nil {
+		return nil
+	}
+
+	for _, h := range p.Holdings {
+		if h.AssetId.Equals(assetId) {
+			return h
+		}
+	}
+
+	return nil
+}
+
+func (p *AccountPortfolio) String() string {
+	return str.StructToString(
+		"AccountPortfolio",
+		str.NewField("Address", str.StringPattern, p.Address),
+		str.NewField("Holdings", str.StringPattern, p.Holdings),
+	)
+}
+
 // returns new Account generated for passed NetworkType
 func NewAccount(networkType NetworkType, generationHash *Hash) (*Account, error) {
 	kp, err := crypto.NewKeyPairByEngine(crypto.CryptoEngines.DefaultEngine)
@@ -275,6 +275,56 @@ func TestAccountService_GetAccountsNames(t *testing.T) {
 	})
 }
 
+func TestAccountService_GetAccountPortfolio(t *testing.T) {
+	mockServ := newSdkMockWithRouter(&mock.Router{
+		Path:     accountsRoute,
+		RespBody: "[" + accountInfoJson + "]",
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     mosaicsRoute,
+		RespBody: "[" + testMosaicInfoJson + "]",
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path: mosaicNamesRoute,
+		RespBody: `[
+   {
+      "mosaicId":[
+         298950589,
+         1817567325
+      ],
+      "names":[
+         "prx.xpx"
+      ]
+   }
+]`,
+	})
+
+	accClient := mockServ.getPublicTestClientUnsafe().Account
+
+	t.Run("return portfolio as expect", func(t *testing.T) {
+		portfolio, err := accClient.GetAccountPortfolio(ctx, &Address{MijinTest, nemTestAddress1})
+		assert.Nilf(t, err, "AccountService.GetAccountPortfolio returned error: %s", err)
+
+		assert.Equal(t, account.Address.Address, portfolio.Address.Address)
+		assert.Len(t, portfolio.Holdings, 1)
+
+		holding := portfolio.HoldingByName("prx.xpx")
+		assert.NotNil(t, holding)
+		assert.Equal(t, mosaicCorr.MosaicId.Id(), holding.MosaicInfo.MosaicId.Id())
+		assert.Equal(t, uint8(6), holding.Divisibility())
+		assert.Equal(t, "409090909.000000", holding.RelativeAmount())
+		assert.Equal(t, holding, portfolio.HoldingByAssetId(mosaicCorr.MosaicId))
+
+		assert.Nil(t, portfolio.HoldingByName("nem.xem"))
+	})
+
+	t.Run("return error for nil address as expect", func(t *testing.T) {
+		_, err := accClient.GetAccountPortfolio(ctx, nil)
+
+		assert.EqualError(t, err, ErrNilAddress.Error())
+	})
+}
+
 func newAddressFromRaw(addressString string) (address *Address) {
 	address, err := NewAddressFromRaw(addressString)
 	if err != nil {
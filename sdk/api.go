@@ -0,0 +1,216 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"time"
+)
+
+// AccountApi is implemented by AccountService, interfaces of services can be replaced by mocks from mocks/services in tests
+type AccountApi interface {
+	GetAccountProperties(ctx context.Context, address *Address) (*AccountProperties, error)
+	GetAccountsProperties(ctx context.Context, addresses ...*Address) ([]*AccountProperties, error)
+	GetAccountInfo(ctx context.Context, address *Address) (*AccountInfo, error)
+	GetAccountsInfo(ctx context.Context, addresses ...*Address) ([]*AccountInfo, error)
+	GetMultisigAccountInfo(ctx context.Context, address *Address) (*MultisigAccountInfo, error)
+	GetMultisigAccountGraphInfo(ctx context.Context, address *Address) (*MultisigAccountGraphInfo, error)
+	GetMultisigAccountTree(ctx context.Context, address *Address) (*MultisigNode, error)
+	GetAccountNames(ctx context.Context, addr ...*Address) ([]*AccountName, error)
+	Transactions(ctx context.Context, account *PublicAccount, opt *AccountTransactionsOption) ([]Transaction, error)
+	IncomingTransactions(ctx context.Context, account *PublicAccount, opt *AccountTransactionsOption) ([]Transaction, error)
+	OutgoingTransactions(ctx context.Context, account *PublicAccount, opt *AccountTransactionsOption) ([]Transaction, error)
+	UnconfirmedTransactions(ctx context.Context, account *PublicAccount, opt *AccountTransactionsOption) ([]Transaction, error)
+	AggregateBondedTransactions(ctx context.Context, account *PublicAccount, opt *AccountTransactionsOption) ([]*AggregateTransaction, error)
+	GetAccountPortfolio(ctx context.Context, address *Address) (*AccountPortfolio, error)
+	GetAccountsPortfolios(ctx context.Context, addresses ...*Address) ([]*AccountPortfolio, error)
+}
+
+// BlockchainApi is implemented by BlockchainService
+type BlockchainApi interface {
+	GetBlockByHeight(ctx context.Context, height Height) (*BlockInfo, error)
+	GetBlockTransactions(ctx context.Context, height Height) ([]Transaction, error)
+	GetBlocksByHeightWithLimit(ctx context.Context, height Height, limit Amount) ([]*BlockInfo, error)
+	GetBlockchainHeight(ctx context.Context) (Height, error)
+	GetBlockchainScore(ctx context.Context) (*ChainScore, error)
+	GetBlockchainStorage(ctx context.Context) (*BlockchainStorageInfo, error)
+}
+
+// MosaicApi is implemented by MosaicService
+type MosaicApi interface {
+	GetMosaicInfo(ctx context.Context, mosaicId *MosaicId) (*MosaicInfo, error)
+	GetMosaicInfos(ctx context.Context, mscIds []*MosaicId) ([]*MosaicInfo, error)
+	GetMosaicsNames(ctx context.Context, mscIds ...*MosaicId) ([]*MosaicName, error)
+}
+
+// NamespaceApi is implemented by NamespaceService
+type NamespaceApi interface {
+	GetNamespaceInfo(ctx context.Context, nsId *NamespaceId) (*NamespaceInfo, error)
+	GetNamespaceInfosFromAccount(ctx context.Context, address *Address, nsId *NamespaceId, pageSize int) ([]*NamespaceInfo, error)
+	GetNamespaceInfosFromAccounts(ctx context.Context, addrs []*Address, nsId *NamespaceId, pageSize int) ([]*NamespaceInfo, error)
+	GetNamespaceNames(ctx context.Context, nsIds []*NamespaceId) ([]*NamespaceName, error)
+	GetLinkedMosaicId(ctx context.Context, namespaceId *NamespaceId) (*MosaicId, error)
+	GetLinkedAddress(ctx context.Context, namespaceId *NamespaceId) (*Address, error)
+	GetNamespaceInfoByName(ctx context.Context, name string) (*NamespaceInfo, error)
+	GetNamespaceInfosByPath(ctx context.Context, name string) ([]*NamespaceInfo, error)
+	GetNamespaceTree(ctx context.Context, nsId *NamespaceId) (*NamespaceNode, error)
+	GetNamespaceTreeByName(ctx context.Context, name string) (*NamespaceNode, error)
+}
+
+// NetworkApi is implemented by NetworkService
+type NetworkApi interface {
+	GetNetworkType(ctx context.Context) (NetworkType, error)
+	GetNetworkConfigAtHeight(ctx context.Context, height Height) (*BlockchainConfig, error)
+	GetNetworkConfig(ctx context.Context) (*BlockchainConfig, error)
+	GetNetworkVersionAtHeight(ctx context.Context, height Height) (*NetworkVersion, error)
+	GetNetworkVersion(ctx context.Context) (*NetworkVersion, error)
+}
+
+// TransactionApi is implemented by TransactionService
+type TransactionApi interface {
+	GetTransaction(ctx context.Context, id string) (Transaction, error)
+	GetTransactions(ctx context.Context, ids []string) ([]Transaction, error)
+	Announce(ctx context.Context, tx *SignedTransaction) (string, error)
+	AnnounceAggregateBonded(ctx context.Context, tx *SignedTransaction) (string, error)
+	AnnounceAggregateBondedCosignature(ctx context.Context, c *CosignatureSignedTransaction) (string, error)
+	GetTransactionStatus(ctx context.Context, id string) (*TransactionStatus, error)
+	GetTransactionStatuses(ctx context.Context, hashes []string) ([]*TransactionStatus, error)
+	GetTransactionEffectiveFee(ctx context.Context, transactionId string) (int, error)
+}
+
+// ResolverApi is implemented by ResolverService
+type ResolverApi interface {
+	GetMosaicInfoByAssetId(ctx context.Context, assetId AssetId) (*MosaicInfo, error)
+	GetMosaicInfosByAssetIds(ctx context.Context, assetIds ...AssetId) ([]*MosaicInfo, error)
+}
+
+// ContractApi is implemented by ContractService
+type ContractApi interface {
+	GetContractsInfo(ctx context.Context, contractPubKeys ...string) ([]*ContractInfo, error)
+	GetContractsByAddress(ctx context.Context, address string) ([]*ContractInfo, error)
+}
+
+// MetadataApi is implemented by MetadataService
+type MetadataApi interface {
+	GetAddressMetadatasInfo(ctx context.Context, addresses ...string) ([]*AddressMetadataInfo, error)
+	GetMosaicMetadatasInfo(ctx context.Context, mosaicIds ...*MosaicId) ([]*MosaicMetadataInfo, error)
+	GetNamespaceMetadatasInfo(ctx context.Context, namespaceIds ...*NamespaceId) ([]*NamespaceMetadataInfo, error)
+	GetMetadataByAddress(ctx context.Context, address string) (*AddressMetadataInfo, error)
+	GetMetadataByMosaicId(ctx context.Context, mosaicId *MosaicId) (*MosaicMetadataInfo, error)
+	GetMetadataByNamespaceId(ctx context.Context, namespaceId *NamespaceId) (*NamespaceMetadataInfo, error)
+}
+
+// LockApi is implemented by LockService
+type LockApi interface {
+	GetHashLockInfosByAccount(ctx context.Context, address *Address) ([]*HashLockInfo, error)
+	GetHashLockInfo(ctx context.Context, hash *Hash) (*HashLockInfo, error)
+	IsHashLockActive(ctx context.Context, hash *Hash) (bool, error)
+	GetSecretLockInfosByAccount(ctx context.Context, address *Address) ([]*SecretLockInfo, error)
+	GetSecretLockInfosBySecret(ctx context.Context, secret *Secret) ([]*SecretLockInfo, error)
+	GetSecretLockInfo(ctx context.Context, compositeHash *Hash) (*SecretLockInfo, error)
+	GetSecretLockInfoByRecipient(ctx context.Context, secret *Secret, recipient *Address) (*SecretLockInfo, error)
+}
+
+// ClientApi is implemented by Client, services are accessed by methods named after their interfaces
+type ClientApi interface {
+	AccountApi() AccountApi
+	BlockchainApi() BlockchainApi
+	MosaicApi() MosaicApi
+	NamespaceApi() NamespaceApi
+	NetworkApi() NetworkApi
+	TransactionApi() TransactionApi
+	ResolverApi() ResolverApi
+	ContractApi() ContractApi
+	MetadataApi() MetadataApi
+	LockApi() LockApi
+
+	NewMultisigModificationPlan(account *PublicAccount, current *MultisigAccountInfo, target *MultisigTarget, deadline *Deadline) (*MultisigModificationPlan, error)
+	NetworkType() NetworkType
+	GenerationHash() *Hash
+	VerifyNodes(ctx context.Context) ([]*NodeIdentity, error)
+	MonitorNetworkIdentity(ctx context.Context, interval time.Duration, handler func([]*NodeIdentity), errHandler func(error))
+	AdaptAccount(account *Account) (*Account, error)
+	NewAccount() (*Account, error)
+	NewAccountFromPrivateKey(pKey string) (*Account, error)
+	NewAccountFromPublicKey(pKey string) (*PublicAccount, error)
+	NewAddressAliasTransaction(deadline *Deadline, address *Address, namespaceId *NamespaceId, actionType AliasActionType) (*AddressAliasTransaction, error)
+	NewMosaicAliasTransaction(deadline *Deadline, mosaicId *MosaicId, namespaceId *NamespaceId, actionType AliasActionType) (*MosaicAliasTransaction, error)
+	NewAccountLinkTransaction(deadline *Deadline, remoteAccount *PublicAccount, linkAction AccountLinkAction) (*AccountLinkTransaction, error)
+	NewAccountPropertiesAddressTransaction(deadline *Deadline, propertyType PropertyType, modifications []*AccountPropertiesAddressModification) (*AccountPropertiesAddressTransaction, error)
+	NewAccountPropertiesMosaicTransaction(deadline *Deadline, propertyType PropertyType, modifications []*AccountPropertiesMosaicModification) (*AccountPropertiesMosaicTransaction, error)
+	NewAccountPropertiesEntityTypeTransaction(deadline *Deadline, propertyType PropertyType, modifications []*AccountPropertiesEntityTypeModification) (*AccountPropertiesEntityTypeTransaction, error)
+	NewNetworkConfigTransaction(deadline *Deadline, delta Duration, config *NetworkConfig, entities *SupportedEntities) (*NetworkConfigTransaction, error)
+	NewBlockchainUpgradeTransaction(deadline *Deadline, upgradePeriod Duration, newBlockChainVersion BlockChainVersion) (*BlockchainUpgradeTransaction, error)
+	NewCompleteAggregateTransaction(deadline *Deadline, innerTxs []Transaction) (*AggregateTransaction, error)
+	NewBondedAggregateTransaction(deadline *Deadline, innerTxs []Transaction) (*AggregateTransaction, error)
+	NewModifyMetadataAddressTransaction(deadline *Deadline, address *Address, modifications []*MetadataModification) (*ModifyMetadataAddressTransaction, error)
+	NewModifyMetadataMosaicTransaction(deadline *Deadline, mosaicId *MosaicId, modifications []*MetadataModification) (*ModifyMetadataMosaicTransaction, error)
+	NewModifyMetadataNamespaceTransaction(deadline *Deadline, namespaceId *NamespaceId, modifications []*MetadataModification) (*ModifyMetadataNamespaceTransaction, error)
+	NewModifyMultisigAccountTransaction(deadline *Deadline, minApprovalDelta int8, minRemovalDelta int8, modifications []*MultisigCosignatoryModification) (*ModifyMultisigAccountTransaction, error)
+	NewModifyContractTransaction(deadline *Deadline, durationDelta Duration, hash *Hash, customers []*MultisigCosignatoryModification, executors []*MultisigCosignatoryModification, verifiers []*MultisigCosignatoryModification) (*ModifyContractTransaction, error)
+	NewMosaicDefinitionTransaction(deadline *Deadline, nonce uint32, ownerPublicKey string, mosaicProps *MosaicProperties) (*MosaicDefinitionTransaction, error)
+	NewMosaicSupplyChangeTransaction(deadline *Deadline, assetId AssetId, supplyType MosaicSupplyType, delta Duration) (*MosaicSupplyChangeTransaction, error)
+	NewTransferTransaction(deadline *Deadline, recipient *Address, mosaics []*Mosaic, message Message) (*TransferTransaction, error)
+	NewTransferTransactionWithNamespace(deadline *Deadline, recipient *NamespaceId, mosaics []*Mosaic, message Message) (*TransferTransaction, error)
+	NewRegisterRootNamespaceTransaction(deadline *Deadline, namespaceName string, duration Duration) (*RegisterNamespaceTransaction, error)
+	NewRegisterSubNamespaceTransaction(deadline *Deadline, namespaceName string, parentId *NamespaceId) (*RegisterNamespaceTransaction, error)
+	NewLockFundsTransaction(deadline *Deadline, mosaic *Mosaic, duration Duration, signedTx *SignedTransaction) (*LockFundsTransaction, error)
+	NewSecretLockTransaction(deadline *Deadline, mosaic *Mosaic, duration Duration, secret *Secret, recipient *Address) (*SecretLockTransaction, error)
+	NewSecretProofTransaction(deadline *Deadline, hashType HashType, proof *Proof, recipient *Address) (*SecretProofTransaction, error)
+}
+
+var (
+	_ ClientApi      = (*Client)(nil)
+	_ AccountApi     = (*AccountService)(nil)
+	_ BlockchainApi  = (*BlockchainService)(nil)
+	_ MosaicApi      = (*MosaicService)(nil)
+	_ NamespaceApi   = (*NamespaceService)(nil)
+	_ NetworkApi     = (*NetworkService)(nil)
+	_ TransactionApi = (*TransactionService)(nil)
+	_ ResolverApi    = (*ResolverService)(nil)
+	_ ContractApi    = (*ContractService)(nil)
+	_ MetadataApi    = (*MetadataService)(nil)
+	_ LockApi        = (*LockService)(nil)
+)
+
+func (c *Client) AccountApi() AccountApi {
+	return c.Account
+}
+
+func (c *Client) BlockchainApi() BlockchainApi {
+	return c.Blockchain
+}
+
+func (c *Client) MosaicApi() MosaicApi {
+	return c.Mosaic
+}
+
+func (c *Client) NamespaceApi() NamespaceApi {
+	return c.Namespace
+}
+
+func (c *Client) NetworkApi() NetworkApi {
+	return c.Network
+}
+
+func (c *Client) TransactionApi() TransactionApi {
+	return c.Transaction
+}
+
+func (c *Client) ResolverApi() ResolverApi {
+	return c.Resolve
+}
+
+func (c *Client) ContractApi() ContractApi {
+	return c.Contract
+}
+
+func (c *Client) MetadataApi() MetadataApi {
+	return c.Metadata
+}
+
+func (c *Client) LockApi() LockApi {
+	return c.Lock
+}
@@ -0,0 +1,421 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigFile describes Config in YAML or JSON file, durations are strings like "5s" or "1m30s"
+//
+//	nodes: ["http://127.0.0.1:3000", "http://127.0.0.1:3001"]
+//	networkType: mijinTest
+//	generationHash: 7B631D803F912B00DC0CBED3014BBD17A302BA50B99D233B9C2D9533B842ABDF
+//	feeStrategy: middle
+//	offline: false
+//	pinIdentity: true
+//	reputation: {minInteractions: 10, defaultReputation: 0.9}
+//	websocket: {reconnectionTimeout: 5s, pingInterval: 30s, staleTimeout: 2m, maxBackoff: 1m, maxAttempts: 0}
+//	retry: {attempts: 2, delay: 1s}
+//	timeouts: {read: 10s, announce: 30s, services: {Account: {read: 20s}}}
+//
+// every value can be overridden by environment variable, see Env constants
+// network type and generation hash, which are not set, are requested from nodes unless `offline` is set
+// `pinIdentity` pins network type and generation hash, which should be set then, see NetworkIdentity
+type ConfigFile struct {
+	Nodes          []string             `json:"nodes" yaml:"nodes"`
+	NetworkType    string               `json:"networkType" yaml:"networkType"`
+	GenerationHash string               `json:"generationHash" yaml:"generationHash"`
+	FeeStrategy    string               `json:"feeStrategy" yaml:"feeStrategy"`
+	Offline        bool                 `json:"offline" yaml:"offline"`
+	PinIdentity    bool                 `json:"pinIdentity" yaml:"pinIdentity"`
+	Reputation     ReputationConfigFile `json:"reputation" yaml:"reputation"`
+	Websocket      WebsocketConfigFile  `json:"websocket" yaml:"websocket"`
+	Retry          RetryConfigFile      `json:"retry" yaml:"retry"`
+	Timeouts       TimeoutsConfigFile   `json:"timeouts" yaml:"timeouts"`
+}
+
+type ReputationConfigFile struct {
+	MinInteractions   *uint64  `json:"minInteractions" yaml:"minInteractions"`
+	DefaultReputation *float64 `json:"defaultReputation" yaml:"defaultReputation"`
+}
+
+type WebsocketConfigFile struct {
+	ReconnectionTimeout string `json:"reconnectionTimeout" yaml:"reconnectionTimeout"`
+	PingInterval        string `json:"pingInterval" yaml:"pingInterval"`
+	StaleTimeout        string `json:"staleTimeout" yaml:"staleTimeout"`
+	MaxBackoff          string `json:"maxBackoff" yaml:"maxBackoff"`
+	MaxAttempts         int    `json:"maxAttempts" yaml:"maxAttempts"`
+}
+
+type RetryConfigFile struct {
+	Attempts int    `json:"attempts" yaml:"attempts"`
+	Delay    string `json:"delay" yaml:"delay"`
+}
+
+type RouteTimeoutsConfigFile struct {
+	Read     string `json:"read" yaml:"read"`
+	Announce string `json:"announce" yaml:"announce"`
+}
+
+type TimeoutsConfigFile struct {
+	RouteTimeoutsConfigFile `json:",inline" yaml:",inline"`
+	Services                map[string]RouteTimeoutsConfigFile `json:"services" yaml:"services"`
+}
+
+// environment variables, which override values of ConfigFile
+// XPX_NODES is comma separated list, XPX_OFFLINE and XPX_PIN_IDENTITY are booleans like "true" or "1"
+const (
+	EnvNodes                 = "XPX_NODES"
+	EnvNetworkType           = "XPX_NETWORK_TYPE"
+	EnvGenerationHash        = "XPX_GENERATION_HASH"
+	EnvFeeStrategy           = "XPX_FEE_STRATEGY"
+	EnvOffline               = "XPX_OFFLINE"
+	EnvPinIdentity           = "XPX_PIN_IDENTITY"
+	EnvReputationMinInter    = "XPX_REPUTATION_MIN_INTERACTIONS"
+	EnvReputationDefault     = "XPX_REPUTATION_DEFAULT"
+	EnvWsReconnectionTimeout = "XPX_WS_RECONNECTION_TIMEOUT"
+	EnvWsPingInterval        = "XPX_WS_PING_INTERVAL"
+	EnvWsStaleTimeout        = "XPX_WS_STALE_TIMEOUT"
+	EnvWsMaxBackoff          = "XPX_WS_MAX_BACKOFF"
+	EnvWsMaxAttempts         = "XPX_WS_MAX_ATTEMPTS"
+	EnvRetryAttempts         = "XPX_RETRY_ATTEMPTS"
+	EnvRetryDelay            = "XPX_RETRY_DELAY"
+	EnvReadTimeout           = "XPX_READ_TIMEOUT"
+	EnvAnnounceTimeout       = "XPX_ANNOUNCE_TIMEOUT"
+)
+
+// returns config built from file at passed path, which is overridden by environment variables
+// file is not read if path is empty, so config can be built only from environment
+func LoadConfig(ctx context.Context, path string) (*Config, error) {
+	file := &ConfigFile{}
+
+	if path != "" {
+		if err := file.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := file.ApplyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+
+	return file.Build(ctx)
+}
+
+// reads YAML or JSON file depending on extension of path, unknown fields are treated as errors
+func (f *ConfigFile) ReadFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, f)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(f)
+	default:
+		return ErrUnknownConfigFormat
+	}
+
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// overrides values of file by environment variables, which are found by passed lookup function, e.g. os.LookupEnv
+func (f *ConfigFile) ApplyEnv(lookup func(key string) (string, bool)) error {
+	setString := func(dst *string) func(string) error {
+		return func(value string) error {
+			*dst = value
+			return nil
+		}
+	}
+
+	setInt := func(dst *int) func(string) error {
+		return func(value string) (err error) {
+			*dst, err = strconv.Atoi(value)
+			return
+		}
+	}
+
+	vars := []struct {
+		key string
+		set func(value string) error
+	}{
+		{EnvNodes, func(value string) error {
+			f.Nodes = splitNodes(value)
+			return nil
+		}},
+		{EnvNetworkType, setString(&f.NetworkType)},
+		{EnvGenerationHash, setString(&f.GenerationHash)},
+		{EnvFeeStrategy, setString(&f.FeeStrategy)},
+		{EnvOffline, func(value string) (err error) {
+			f.Offline, err = strconv.ParseBool(value)
+			return
+		}},
+		{EnvPinIdentity, func(value string) (err error) {
+			f.PinIdentity, err = strconv.ParseBool(value)
+			return
+		}},
+		{EnvReputationMinInter, func(value string) error {
+			n, err := strconv.ParseUint(value, 10, 64)
+			f.Reputation.MinInteractions = &n
+			return err
+		}},
+		{EnvReputationDefault, func(value string) error {
+			r, err := strconv.ParseFloat(value, 64)
+			f.Reputation.DefaultReputation = &r
+			return err
+		}},
+		{EnvWsReconnectionTimeout, setString(&f.Websocket.ReconnectionTimeout)},
+		{EnvWsPingInterval, setString(&f.Websocket.PingInterval)},
+		{EnvWsStaleTimeout, setString(&f.Websocket.StaleTimeout)},
+		{EnvWsMaxBackoff, setString(&f.Websocket.MaxBackoff)},
+		{EnvWsMaxAttempts, setInt(&f.Websocket.MaxAttempts)},
+		{EnvRetryAttempts, setInt(&f.Retry.Attempts)},
+		{EnvRetryDelay, setString(&f.Retry.Delay)},
+		{EnvReadTimeout, setString(&f.Timeouts.Read)},
+		{EnvAnnounceTimeout, setString(&f.Timeouts.Announce)},
+	}
+
+	for _, v := range vars {
+		value, ok := lookup(v.key)
+		if !ok {
+			continue
+		}
+
+		if err := v.set(strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("%s: %w", v.key, err)
+		}
+	}
+
+	return nil
+}
+
+// returns validated config, defaults are used for values, which are not set
+// network type and generation hash, which are not set, are requested from nodes unless `Offline` is set
+// nodes are verified against pinned network identity unless `Offline` is set, it fails if no node matches
+func (f *ConfigFile) Build(ctx context.Context) (*Config, error) {
+	if len(f.Nodes) == 0 {
+		return nil, ErrEmptyBaseUrls
+	}
+
+	for _, node := range f.Nodes {
+		u, err := url.Parse(node)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%s: %w", node, ErrInvalidBaseUrl)
+		}
+	}
+
+	networkType, err := parseNetworkType(f.NetworkType)
+	if err != nil {
+		return nil, err
+	}
+
+	var generationHash *Hash
+	if f.GenerationHash != "" {
+		if generationHash, err = StringToHash(f.GenerationHash); err != nil {
+			return nil, fmt.Errorf("generationHash: %w", err)
+		}
+	}
+
+	if f.Offline && (networkType == NotSupportedNet || generationHash == nil) {
+		return nil, ErrOfflineConfigIncomplete
+	}
+
+	var identity *NetworkIdentity
+	if f.PinIdentity {
+		if identity, err = NewNetworkIdentity(generationHash, networkType); err != nil {
+			return nil, err
+		}
+	}
+
+	strategy, err := parseFeeStrategy(f.FeeStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	repConf, err := f.Reputation.build()
+	if err != nil {
+		return nil, err
+	}
+
+	d := durationParser{}
+	wsReconnectionTimeout := d.parse("websocket.reconnectionTimeout", f.Websocket.ReconnectionTimeout, DefaultWebsocketReconnectionTimeout)
+	websocket := &WebsocketConfig{
+		PingInterval: d.parse("websocket.pingInterval", f.Websocket.PingInterval, 0),
+		StaleTimeout: d.parse("websocket.staleTimeout", f.Websocket.StaleTimeout, 0),
+		MaxBackoff:   d.parse("websocket.maxBackoff", f.Websocket.MaxBackoff, 0),
+		MaxAttempts:  f.Websocket.MaxAttempts,
+	}
+	retry := &RetryPolicy{
+		Attempts: f.Retry.Attempts,
+		Delay:    d.parse("retry.delay", f.Retry.Delay, 0),
+	}
+	timeouts := &Timeouts{
+		RouteTimeouts: f.Timeouts.RouteTimeoutsConfigFile.build(&d, "timeouts"),
+	}
+
+	for service, t := range f.Timeouts.Services {
+		if timeouts.Services == nil {
+			timeouts.Services = make(map[string]RouteTimeouts)
+		}
+
+		timeouts.Services[service] = t.build(&d, "timeouts.services."+service)
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if websocket.MaxAttempts < 0 {
+		return nil, fmt.Errorf("websocket.maxAttempts: %w", ErrNegativeConfigValue)
+	}
+
+	if retry.Attempts < 0 {
+		return nil, fmt.Errorf("retry.attempts: %w", ErrNegativeConfigValue)
+	}
+
+	conf, err := NewConfigWithReputation(f.Nodes, networkType, repConf, wsReconnectionTimeout, generationHash, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	conf.Websocket = websocket
+	conf.Retry = retry
+	conf.Timeouts = timeouts
+	conf.Identity = identity
+
+	if f.Offline {
+		return conf, nil
+	}
+
+	if identity != nil {
+		if _, err := NewClient(nil, conf).VerifyNodes(ctx); err != nil {
+			return nil, err
+		}
+
+		return conf, nil
+	}
+
+	if err := conf.requestNetworkIdentity(ctx); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+func (r *ReputationConfigFile) build() (*reputationConfig, error) {
+	conf := defaultRepConfig
+
+	if r.MinInteractions != nil {
+		conf.minInteractions = *r.MinInteractions
+	}
+
+	if r.DefaultReputation != nil {
+		conf.defaultReputation = *r.DefaultReputation
+	}
+
+	return NewReputationConfig(conf.minInteractions, conf.defaultReputation)
+}
+
+func (t *RouteTimeoutsConfigFile) build(d *durationParser, prefix string) RouteTimeouts {
+	return RouteTimeouts{
+		Read:     d.parse(prefix+".read", t.Read, 0),
+		Announce: d.parse(prefix+".announce", t.Announce, 0),
+	}
+}
+
+// durationParser keeps the first error of parsed durations
+type durationParser struct {
+	err error
+}
+
+// returns parsed duration or default value if passed value is empty
+func (d *durationParser) parse(field, value string, defaultValue time.Duration) time.Duration {
+	if value == "" || d.err != nil {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err == nil && duration < 0 {
+		err = ErrNegativeConfigValue
+	}
+
+	if err != nil {
+		d.err = fmt.Errorf("%s: %w", field, err)
+		return defaultValue
+	}
+
+	return duration
+}
+
+// returns NetworkType from name like "mijinTest" or from number, NotSupportedNet if value is empty
+func parseNetworkType(value string) (NetworkType, error) {
+	if value == "" {
+		return NotSupportedNet, nil
+	}
+
+	if networkType := NetworkTypeFromString(value); networkType != NotSupportedNet {
+		return networkType, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 8)
+	if err != nil || n == uint64(NotSupportedNet) {
+		return NotSupportedNet, fmt.Errorf("%s: %w", value, ErrInvalidNetworkType)
+	}
+
+	return NetworkType(n), nil
+}
+
+// returns FeeCalculationStrategy from name like "middle" or from number, DefaultFeeCalculationStrategy if value is empty
+func parseFeeStrategy(value string) (FeeCalculationStrategy, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return DefaultFeeCalculationStrategy, nil
+	case "high":
+		return HighCalculationStrategy, nil
+	case "middle":
+		return MiddleCalculationStrategy, nil
+	case "low":
+		return LowCalculationStrategy, nil
+	}
+
+	n, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", value, ErrInvalidFeeStrategy)
+	}
+
+	return FeeCalculationStrategy(n), nil
+}
+
+func splitNodes(value string) []string {
+	var nodes []string
+
+	for _, node := range strings.Split(value, ",") {
+		if node = strings.TrimSpace(node); node != "" {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-utils/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testConfigGenerationHash = "7B631D803F912B00DC0CBED3014BBD17A302BA50B99D233B9C2D9533B842ABDF"
+
+	testConfigYAML = `
+nodes: ["http://127.0.0.1:3000", "https://node.example.com:3001"]
+networkType: mijinTest
+generationHash: 7B631D803F912B00DC0CBED3014BBD17A302BA50B99D233B9C2D9533B842ABDF
+feeStrategy: high
+offline: true
+reputation: {minInteractions: 5, defaultReputation: 0.5}
+websocket: {reconnectionTimeout: 2s, pingInterval: 10s, staleTimeout: 2m, maxBackoff: 1m, maxAttempts: 7}
+retry: {attempts: 3, delay: 500ms}
+timeouts: {read: 10s, announce: 30s, services: {Account: {read: 20s}}}
+`
+
+	testConfigJSON = `{
+  "nodes": ["http://127.0.0.1:3000"],
+  "networkType": "144",
+  "generationHash": "7B631D803F912B00DC0CBED3014BBD17A302BA50B99D233B9C2D9533B842ABDF",
+  "offline": true,
+  "timeouts": {"read": "5s"}
+}`
+)
+
+func writeTestConfig(t *testing.T, name, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "sdk-config")
+	assert.Nil(t, err)
+
+	path := filepath.Join(dir, name)
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	return path, func() { os.RemoveAll(dir) }
+}
+
+func noEnv(string) (string, bool) {
+	return "", false
+}
+
+func TestConfigFile_YAML(t *testing.T) {
+	path, cleanup := writeTestConfig(t, "config.yaml", testConfigYAML)
+	defer cleanup()
+
+	file := &ConfigFile{}
+	assert.Nil(t, file.ReadFile(path))
+	assert.Nil(t, file.ApplyEnv(noEnv))
+
+	conf, err := file.Build(context.Background())
+	assert.Nil(t, err)
+
+	assert.Len(t, conf.BaseURLs, 2)
+	assert.Equal(t, "node.example.com:3001", conf.BaseURLs[1].Host)
+	assert.Equal(t, conf.BaseURLs[0], conf.GetUsedBaseUrl())
+	assert.Equal(t, MijinTest, conf.NetworkType)
+	assert.Equal(t, stringToHashPanic(testConfigGenerationHash), conf.GenerationHash)
+	assert.Equal(t, HighCalculationStrategy, conf.FeeCalculationStrategy)
+	assert.Equal(t, &reputationConfig{minInteractions: 5, defaultReputation: 0.5}, conf.reputationConfig)
+	assert.Equal(t, 2*time.Second, conf.WsReconnectionTimeout)
+	assert.Equal(t, &WebsocketConfig{PingInterval: 10 * time.Second, StaleTimeout: 2 * time.Minute, MaxBackoff: time.Minute, MaxAttempts: 7}, conf.Websocket)
+	assert.Equal(t, &RetryPolicy{Attempts: 3, Delay: 500 * time.Millisecond}, conf.Retry)
+	assert.Equal(t, &Timeouts{
+		RouteTimeouts: RouteTimeouts{Read: 10 * time.Second, Announce: 30 * time.Second},
+		Services:      map[string]RouteTimeouts{"Account": {Read: 20 * time.Second}},
+	}, conf.Timeouts)
+}
+
+func TestConfigFile_JSONWithEnv(t *testing.T) {
+	path, cleanup := writeTestConfig(t, "config.json", testConfigJSON)
+	defer cleanup()
+
+	env := map[string]string{
+		EnvNodes:             "http://10.0.0.1:3000, http://10.0.0.2:3000",
+		EnvFeeStrategy:       "low",
+		EnvReputationDefault: "0.7",
+		EnvRetryAttempts:     "2",
+		EnvAnnounceTimeout:   "1m",
+	}
+
+	file := &ConfigFile{}
+	assert.Nil(t, file.ReadFile(path))
+	assert.Nil(t, file.ApplyEnv(func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}))
+
+	conf, err := file.Build(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"10.0.0.1:3000", "10.0.0.2:3000"}, []string{conf.BaseURLs[0].Host, conf.BaseURLs[1].Host})
+	assert.Equal(t, MijinTest, conf.NetworkType)
+	assert.Equal(t, LowCalculationStrategy, conf.FeeCalculationStrategy)
+	assert.Equal(t, &reputationConfig{minInteractions: defaultRepConfig.minInteractions, defaultReputation: 0.7}, conf.reputationConfig)
+	assert.Equal(t, DefaultWebsocketReconnectionTimeout, conf.WsReconnectionTimeout)
+	assert.Equal(t, 2, conf.Retry.Attempts)
+	assert.Equal(t, RouteTimeouts{Read: 5 * time.Second, Announce: time.Minute}, conf.Timeouts.RouteTimeouts)
+}
+
+func TestConfigFile_Validation(t *testing.T) {
+	valid := func() *ConfigFile {
+		return &ConfigFile{
+			Nodes:          []string{"http://127.0.0.1:3000"},
+			NetworkType:    "mijinTest",
+			GenerationHash: testConfigGenerationHash,
+			Offline:        true,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(f *ConfigFile)
+		err    error
+	}{
+		{"no nodes", func(f *ConfigFile) { f.Nodes = nil }, ErrEmptyBaseUrls},
+		{"relative node", func(f *ConfigFile) { f.Nodes = []string{"127.0.0.1:3000"} }, ErrInvalidBaseUrl},
+		{"ws node", func(f *ConfigFile) { f.Nodes = []string{"ws://127.0.0.1:3000"} }, ErrInvalidBaseUrl},
+		{"network type", func(f *ConfigFile) { f.NetworkType = "mainnet" }, ErrInvalidNetworkType},
+		{"generation hash", func(f *ConfigFile) { f.GenerationHash = "7B63" }, ErrInvalidHashLength},
+		{"offline without hash", func(f *ConfigFile) { f.GenerationHash = "" }, ErrOfflineConfigIncomplete},
+		{"offline without network", func(f *ConfigFile) { f.NetworkType = "" }, ErrOfflineConfigIncomplete},
+		{"fee strategy", func(f *ConfigFile) { f.FeeStrategy = "fast" }, ErrInvalidFeeStrategy},
+		{"reputation", func(f *ConfigFile) { f.Reputation.DefaultReputation = Float64(1.5) }, ErrInvalidReputationConfig},
+		{"negative duration", func(f *ConfigFile) { f.Retry.Delay = "-1s" }, ErrNegativeConfigValue},
+		{"negative attempts", func(f *ConfigFile) { f.Websocket.MaxAttempts = -1 }, ErrNegativeConfigValue},
+		{"pinned without hash", func(f *ConfigFile) { f.Offline, f.PinIdentity, f.GenerationHash = false, true, "" }, ErrIncompleteNetworkIdentity},
+	}
+
+	for _, tt := range tests {
+		f := valid()
+		tt.modify(f)
+
+		_, err := f.Build(context.Background())
+		assert.True(t, errors.Is(err, tt.err), "%s: %v", tt.name, err)
+	}
+
+	f := valid()
+	f.Timeouts.Services = map[string]RouteTimeoutsConfigFile{"Account": {Read: "soon"}}
+	_, err := f.Build(context.Background())
+	assert.EqualError(t, err, `timeouts.services.Account.read: time: invalid duration "soon"`)
+
+	_, err = valid().Build(context.Background())
+	assert.Nil(t, err)
+}
+
+func TestConfigFile_ReadFileErrors(t *testing.T) {
+	path, cleanup := writeTestConfig(t, "config.yaml", "nodes: [http://127.0.0.1:3000]\nnode: http://127.0.0.1:3001\n")
+	defer cleanup()
+
+	assert.NotNil(t, (&ConfigFile{}).ReadFile(path))
+
+	path, cleanup = writeTestConfig(t, "config.json", `{"nodes": ["http://127.0.0.1:3000"], "offlne": true}`)
+	defer cleanup()
+
+	assert.NotNil(t, (&ConfigFile{}).ReadFile(path))
+
+	path, cleanup = writeTestConfig(t, "config.toml", `nodes = []`)
+	defer cleanup()
+
+	assert.Equal(t, ErrUnknownConfigFormat, (&ConfigFile{}).ReadFile(path))
+
+	err := (&ConfigFile{}).ApplyEnv(func(key string) (string, bool) {
+		return "many", key == EnvRetryAttempts
+	})
+	assert.Contains(t, err.Error(), EnvRetryAttempts)
+}
+
+func TestLoadConfig_RequestsNetworkIdentity(t *testing.T) {
+	server := newSdkMockWithRouter(&mock.Router{
+		Path:     fmt.Sprintf(blockByHeightRoute, Height(1)),
+		RespBody: blockInfoJSON,
+	})
+	server.AddRouter(&mock.Router{
+		Path:     networkRoute,
+		RespBody: mijinTestRoute,
+	})
+	defer server.Close()
+
+	path, cleanup := writeTestConfig(t, "config.yml", fmt.Sprintf("nodes: [%s]\n", server.GetServerURL()))
+	defer cleanup()
+
+	conf, err := LoadConfig(context.Background(), path)
+	assert.Nil(t, err)
+	assert.Equal(t, MijinTest, conf.NetworkType)
+	assert.Equal(t, wantBlockInfo.GenerationHash, conf.GenerationHash)
+}
@@ -71,6 +71,15 @@ const (
 	metadataByNamespaceRoute = "/namespace/%s/metadata"
 )
 
+// routes for LockService
+const (
+	hashLocksByAccountRoute    = "/account/%s/lock/hash"
+	hashLockRoute              = "/lock/hash/%s"
+	secretLocksByAccountRoute  = "/account/%s/lock/secret"
+	secretLocksBySecretRoute   = "/lock/secret/%s"
+	secretLockByCompositeRoute = "/lock/compositeHash/%s"
+)
+
 // routes for NetworkService
 const (
 	networkRoute = "/network"
@@ -57,11 +57,14 @@ var (
 
 // Namespace errors
 var (
-	ErrNamespaceTooManyPart = errors.New("too many parts")
-	ErrNilNamespaceId       = errors.New("namespaceId is nil or zero")
-	ErrWrongBitNamespaceId  = errors.New("namespaceId doesn't have 64th bit")
-	ErrEmptyNamespaceIds    = errors.New("list namespace ids must not by empty")
-	ErrInvalidNamespaceName = errors.New("namespace name is invalid")
+	ErrNamespaceTooManyPart         = errors.New("too many parts")
+	ErrNilNamespaceId               = errors.New("namespaceId is nil or zero")
+	ErrWrongBitNamespaceId          = errors.New("namespaceId doesn't have 64th bit")
+	ErrEmptyNamespaceIds            = errors.New("list namespace ids must not by empty")
+	ErrInvalidNamespaceName         = errors.New("namespace name is invalid")
+	ErrNamespaceNotAliasedToMosaic  = errors.New("namespace is not aliased to mosaic")
+	ErrNamespaceNotAliasedToAddress = errors.New("namespace is not aliased to address")
+	ErrNamespacePageStalled         = errors.New("page of account namespaces does not advance cursor")
 )
 
 // Blockchain errors
@@ -74,6 +77,7 @@ var (
 var (
 	ErrNilSecret = errors.New("Secret should not be nil")
 	ErrNilProof  = errors.New("Proof should not be nil")
+	ErrNilHash   = errors.New("Hash should not be nil")
 )
 
 // plain errors
@@ -85,7 +89,57 @@ var (
 	ErrInvalidAddress    = errors.New("wrong address")
 )
 
+// Network config errors
+var (
+	ErrNetworkConfigSectionNotFound = errors.New("section is not found in network config")
+	ErrNetworkConfigFieldNotFound   = errors.New("field is not found in network config")
+	ErrInvalidNetworkConfigValue    = errors.New("value of network config is invalid")
+)
+
+// Multisig errors
+var (
+	ErrNilMultisigGraph            = errors.New("multisig graph should not be nil")
+	ErrAccountNotInMultisigGraph   = errors.New("account is not found in multisig graph")
+	ErrNilMultisigTarget           = errors.New("multisig target should not be nil")
+	ErrDuplicateCosignatory        = errors.New("cosignatory is duplicated")
+	ErrAccountCosignsItself        = errors.New("account cannot be cosignatory of itself")
+	ErrInvalidMultisigThresholds   = errors.New("min approval and min removal should be in range [1, number of cosignatories]")
+	ErrMultipleCosignatoryRemovals = errors.New("only one cosignatory can be removed by one transaction")
+	ErrEmptyMultisigModification   = errors.New("multisig account is already in the target state")
+)
+
+// Expiry monitor errors
+var (
+	ErrEmptyExpiryTargets  = errors.New("owners and mosaic ids to track must not be empty")
+	ErrZeroRenewalDuration = errors.New("renewal duration should not be zero if signer is set")
+	ErrNilSigner           = errors.New("signer should not be nil")
+)
+
 // reputations error
 var (
 	ErrInvalidReputationConfig = errors.New("default reputation should be greater than 0 and less than 1")
 )
+
+// Request errors, they are returned instead of network errors when request is stopped by context
+var (
+	ErrRequestCanceled = errors.New("request is canceled")
+	ErrRequestTimeout  = errors.New("request deadline is exceeded")
+)
+
+// Config errors
+var (
+	ErrEmptyBaseUrls           = errors.New("empty base urls")
+	ErrInvalidBaseUrl          = errors.New("base url should be absolute http or https url")
+	ErrUnknownConfigFormat     = errors.New("config file should have .yaml, .yml or .json extension")
+	ErrInvalidNetworkType      = errors.New("network type is not supported")
+	ErrInvalidFeeStrategy      = errors.New("fee calculation strategy should be high, middle, low or number")
+	ErrNegativeConfigValue     = errors.New("value should not be negative")
+	ErrOfflineConfigIncomplete = errors.New("network type and generation hash should be set in offline mode")
+)
+
+// Network identity errors
+var (
+	ErrIncompleteNetworkIdentity = errors.New("generation hash and network type should be set to pin network identity")
+	ErrNoNetworkIdentity         = errors.New("network identity is not pinned in config")
+	ErrNetworkIdentityMismatch   = errors.New("node does not match pinned network identity")
+)
@@ -0,0 +1,399 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-utils/str"
+)
+
+const (
+	DefaultExpiryCheckInterval       = time.Minute
+	DefaultRenewalDeadline           = time.Hour
+	DefaultRenewBefore               = 7 * 24 * time.Hour
+	DefaultBlockGenerationTargetTime = 15 * time.Second
+)
+
+// default thresholds of remaining time, after which ExpiryMonitor emits warnings
+var DefaultExpiryThresholds = []time.Duration{
+	30 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	24 * time.Hour,
+}
+
+// ExpiryInfo describes expiration of tracked namespace or mosaic
+type ExpiryInfo struct {
+	AssetId         AssetId
+	Owner           *PublicAccount
+	EndHeight       Height
+	RemainingBlocks uint64
+	RemainingTime   time.Duration
+	NamespaceInfo   *NamespaceInfo
+	MosaicInfo      *MosaicInfo
+}
+
+// returns true if namespace or mosaic is already expired
+func (e *ExpiryInfo) Expired() bool {
+	return e.RemainingBlocks == 0
+}
+
+func (e *ExpiryInfo) String() string {
+	return str.StructToString(
+		"ExpiryInfo",
+		str.NewField("AssetId", str.StringPattern, e.AssetId),
+		str.NewField("Owner", str.StringPattern, e.Owner),
+		str.NewField("EndHeight", str.StringPattern, e.EndHeight),
+		str.NewField("RemainingBlocks", str.IntPattern, e.RemainingBlocks),
+		str.NewField("RemainingTime", str.StringPattern, e.RemainingTime),
+	)
+}
+
+// ExpiryWarning is emitted by ExpiryMonitor when remaining time of namespace or mosaic crosses Threshold
+// Renewal is set if monitor announced renewal transaction for expiring namespace
+type ExpiryWarning struct {
+	*ExpiryInfo
+	Threshold  time.Duration
+	Renewal    *SignedTransaction
+	RenewalErr error
+}
+
+// TransactionSigner signs transactions, which are announced by sdk helpers
+type TransactionSigner interface {
+	Sign(tx Transaction) (*SignedTransaction, error)
+}
+
+// ExpiryMonitorConfig describes what ExpiryMonitor tracks and how it renews namespaces
+// `Owners` - root namespaces of these accounts are tracked, subnamespaces expire together with root
+// `MosaicIds` - mosaics with not eternal duration are tracked
+// `Thresholds` - warning is emitted once per every crossed threshold of remaining time
+// `BlockGenerationTargetTime` - used to convert remaining blocks to time, requested from network config if zero
+// `Signer` - if it is set, renewal RegisterNamespaceTransaction is announced for namespace crossed `RenewBefore`,
+// which is DefaultRenewBefore if zero
+type ExpiryMonitorConfig struct {
+	Owners                    []*Address
+	MosaicIds                 []*MosaicId
+	Thresholds                []time.Duration
+	Interval                  time.Duration
+	BlockGenerationTargetTime time.Duration
+	Signer                    TransactionSigner
+	RenewBefore               time.Duration
+	RenewalDuration           Duration
+}
+
+// ExpiryMonitor tracks expiration of namespaces and mosaics against current chain height
+type ExpiryMonitor struct {
+	client *Client
+	conf   ExpiryMonitorConfig
+
+	sync.Mutex
+	states map[uint64]*expiryState
+}
+
+// expiryState keeps emitted warnings and renewals of asset until it's end height is changed
+type expiryState struct {
+	endHeight Height
+	warned    bool
+	threshold time.Duration
+	renewed   bool
+}
+
+// returns new ExpiryMonitor for passed Client and configuration
+func NewExpiryMonitor(client *Client, conf *ExpiryMonitorConfig) (*ExpiryMonitor, error) {
+	if conf == nil || (len(conf.Owners) == 0 && len(conf.MosaicIds) == 0) {
+		return nil, ErrEmptyExpiryTargets
+	}
+
+	if conf.Signer != nil && conf.RenewalDuration == 0 {
+		return nil, ErrZeroRenewalDuration
+	}
+
+	c := *conf
+
+	if len(c.Thresholds) == 0 {
+		c.Thresholds = DefaultExpiryThresholds
+	}
+
+	c.Thresholds = append([]time.Duration(nil), c.Thresholds...)
+	sort.Slice(c.Thresholds, func(i, j int) bool {
+		return c.Thresholds[i] < c.Thresholds[j]
+	})
+
+	if c.Interval == 0 {
+		c.Interval = DefaultExpiryCheckInterval
+	}
+
+	if c.RenewBefore == 0 {
+		c.RenewBefore = DefaultRenewBefore
+	}
+
+	return &ExpiryMonitor{
+		client: client,
+		conf:   c,
+		states: make(map[uint64]*expiryState),
+	}, nil
+}
+
+// returns ExpiryInfo's of all tracked namespaces and mosaics at current chain height
+func (m *ExpiryMonitor) Check(ctx context.Context) ([]*ExpiryInfo, error) {
+	blockTime, err := m.blockGenerationTargetTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := m.client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*ExpiryInfo, 0)
+
+	for _, owner := range m.conf.Owners {
+		nsInfos, err := m.client.Namespace.getAllNamespaceInfosFromAccount(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nsInfo := range nsInfos {
+			if nsInfo.TypeSpace != Root || isEternalHeight(nsInfo.EndHeight) {
+				continue
+			}
+
+			info := newExpiryInfo(nsInfo.NamespaceId, nsInfo.Owner, nsInfo.EndHeight, height, blockTime)
+			info.NamespaceInfo = nsInfo
+			infos = append(infos, info)
+		}
+	}
+
+	if len(m.conf.MosaicIds) > 0 {
+		mscInfos, err := m.client.Mosaic.GetMosaicInfos(ctx, m.conf.MosaicIds)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mscInfo := range mscInfos {
+			if mscInfo.Properties == nil || mscInfo.Properties.Duration() == 0 {
+				continue
+			}
+
+			endHeight := mscInfo.Height + mscInfo.Properties.Duration()
+			info := newExpiryInfo(mscInfo.MosaicId, mscInfo.Owner, endHeight, height, blockTime)
+			info.MosaicInfo = mscInfo
+			infos = append(infos, info)
+		}
+	}
+
+	return infos, nil
+}
+
+// checks tracked namespaces and mosaics and returns warnings for newly crossed thresholds
+// renewal transaction is announced once for namespace crossed `RenewBefore` if signer is configured,
+// it is announced again only if namespace was not extended after previous renewal
+func (m *ExpiryMonitor) Warnings(ctx context.Context) ([]*ExpiryWarning, error) {
+	infos, err := m.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := make([]*ExpiryWarning, 0)
+
+	for _, info := range infos {
+		threshold, isNew := m.crossedThreshold(info)
+
+		warning := &ExpiryWarning{ExpiryInfo: info, Threshold: threshold}
+
+		if m.shouldRenew(info) {
+			warning.Renewal, warning.RenewalErr = m.Renew(ctx, info)
+			if warning.RenewalErr == nil {
+				m.markRenewed(info)
+			}
+		} else if !isNew {
+			continue
+		}
+
+		warnings = append(warnings, warning)
+	}
+
+	return warnings, nil
+}
+
+// checks expirations every configured interval and calls passed handler for every warning until context is done
+// errors of checks are passed to errHandler if it is not nil
+func (m *ExpiryMonitor) Run(ctx context.Context, handler func(*ExpiryWarning), errHandler func(error)) {
+	ticker := time.NewTicker(m.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		warnings, err := m.Warnings(ctx)
+		if err != nil {
+			if errHandler != nil {
+				errHandler(err)
+			}
+		}
+
+		for _, warning := range warnings {
+			handler(warning)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// announces RegisterNamespaceTransaction, which extends duration of namespace from passed ExpiryInfo
+func (m *ExpiryMonitor) Renew(ctx context.Context, info *ExpiryInfo) (*SignedTransaction, error) {
+	if m.conf.Signer == nil {
+		return nil, ErrNilSigner
+	}
+
+	if info == nil || info.NamespaceInfo == nil {
+		return nil, ErrNilNamespaceId
+	}
+
+	names, err := m.client.Namespace.GetNamespaceNames(ctx, []*NamespaceId{info.NamespaceInfo.NamespaceId})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, ErrResourceNotFound
+	}
+
+	tx, err := m.client.NewRegisterRootNamespaceTransaction(
+		NewDeadline(DefaultRenewalDeadline),
+		names[0].FullName,
+		m.conf.RenewalDuration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stx, err := m.conf.Signer.Sign(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = m.client.Transaction.Announce(ctx, stx); err != nil {
+		return nil, err
+	}
+
+	return stx, nil
+}
+
+func (m *ExpiryMonitor) blockGenerationTargetTime(ctx context.Context) (time.Duration, error) {
+	m.Lock()
+	blockTime := m.conf.BlockGenerationTargetTime
+	m.Unlock()
+
+	if blockTime != 0 {
+		return blockTime, nil
+	}
+
+	conf, err := m.client.Network.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	blockTime, err = conf.NetworkConfig.BlockGenerationTargetTime()
+	if err != nil {
+		blockTime = DefaultBlockGenerationTargetTime
+	}
+
+	m.Lock()
+	m.conf.BlockGenerationTargetTime = blockTime
+	m.Unlock()
+
+	return blockTime, nil
+}
+
+// returns the smallest threshold crossed by passed ExpiryInfo and true if warning for it was not emitted yet
+func (m *ExpiryMonitor) crossedThreshold(info *ExpiryInfo) (time.Duration, bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	id := info.AssetId.Id()
+
+	// asset was extended, so warnings should be emitted again
+	if state, ok := m.states[id]; ok && state.endHeight != info.EndHeight {
+		delete(m.states, id)
+	}
+
+	for _, threshold := range m.conf.Thresholds {
+		if info.RemainingTime > threshold {
+			continue
+		}
+
+		state, ok := m.states[id]
+		if !ok {
+			state = &expiryState{endHeight: info.EndHeight}
+			m.states[id] = state
+		}
+
+		if state.warned && state.threshold <= threshold {
+			return state.threshold, false
+		}
+
+		state.warned = true
+		state.threshold = threshold
+		return threshold, true
+	}
+
+	return 0, false
+}
+
+func (m *ExpiryMonitor) shouldRenew(info *ExpiryInfo) bool {
+	if m.conf.Signer == nil || info.NamespaceInfo == nil || info.RemainingTime > m.conf.RenewBefore {
+		return false
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	state, ok := m.states[info.AssetId.Id()]
+	return !ok || !state.renewed
+}
+
+func (m *ExpiryMonitor) markRenewed(info *ExpiryInfo) {
+	m.Lock()
+	defer m.Unlock()
+
+	state, ok := m.states[info.AssetId.Id()]
+	if !ok {
+		state = &expiryState{endHeight: info.EndHeight}
+		m.states[info.AssetId.Id()] = state
+	}
+
+	state.renewed = true
+}
+
+func newExpiryInfo(assetId AssetId, owner *PublicAccount, endHeight Height, height Height, blockTime time.Duration) *ExpiryInfo {
+	info := &ExpiryInfo{
+		AssetId:   assetId,
+		Owner:     owner,
+		EndHeight: endHeight,
+	}
+
+	if uint64(endHeight) > uint64(height) {
+		info.RemainingBlocks = uint64(endHeight) - uint64(height)
+		info.RemainingTime = time.Duration(math.MaxInt64)
+
+		if blockTime > 0 && info.RemainingBlocks < uint64(math.MaxInt64/blockTime) {
+			info.RemainingTime = time.Duration(info.RemainingBlocks) * blockTime
+		}
+	}
+
+	return info
+}
+
+func isEternalHeight(height Height) bool {
+	return uint64(height) == math.MaxUint64
+}
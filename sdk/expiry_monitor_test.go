@@ -0,0 +1,116 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-utils/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiryMonitor_Warnings(t *testing.T) {
+	owner := &Address{PublicTest, nemTestAddress1}
+	nsId, err := NewNamespaceIdFromName("foo")
+	assert.Nil(t, err)
+	nsArr := nsId.toArray()
+
+	mockServ := newSdkMockWithRouter(&mock.Router{
+		Path:     blockHeightRoute,
+		RespBody: `{"height": [990, 0]}`,
+	})
+	defer mockServ.Close()
+
+	mockServ.AddRouter(&mock.Router{
+		Path:     fmt.Sprintf(namespacesFromAccountRoutes, owner.Address),
+		RespBody: "[" + testNamespaceInfoJson("foo", `{"type": 0}`) + "," + testNamespaceInfoJson("foo.bar", `{"type": 0}`) + "]",
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     fmt.Sprintf(namespaceRoute, nsId.toHexString()),
+		RespBody: testNamespaceInfoJson("foo", `{"type": 0}`),
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     namespaceNamesRoute,
+		RespBody: fmt.Sprintf(`[{"namespaceId": [%d, %d], "name": "foo"}]`, nsArr[0], nsArr[1]),
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     transactionsRoute,
+		RespBody: `{"message": "packet 9 was pushed to the network via /transaction"}`,
+	})
+
+	client := mockServ.getPublicTestClientUnsafe()
+
+	signer, err := client.NewAccount()
+	assert.Nil(t, err)
+
+	t.Run("warn once per threshold", func(t *testing.T) {
+		monitor, err := NewExpiryMonitor(client, &ExpiryMonitorConfig{
+			Owners:                    []*Address{owner},
+			BlockGenerationTargetTime: 15 * time.Second,
+		})
+		assert.Nil(t, err)
+
+		warnings, err := monitor.Warnings(ctx)
+		assert.Nilf(t, err, "ExpiryMonitor.Warnings returned error: %s", err)
+		assert.Len(t, warnings, 1)
+		assert.Equal(t, nsId.Id(), warnings[0].AssetId.Id())
+		assert.Equal(t, uint64(10), warnings[0].RemainingBlocks)
+		assert.Equal(t, 150*time.Second, warnings[0].RemainingTime)
+		assert.Equal(t, 24*time.Hour, warnings[0].Threshold)
+		assert.Nil(t, warnings[0].Renewal)
+
+		warnings, err = monitor.Warnings(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, warnings, 0)
+	})
+
+	t.Run("renew namespace once", func(t *testing.T) {
+		monitor, err := NewExpiryMonitor(client, &ExpiryMonitorConfig{
+			Owners:                    []*Address{owner},
+			BlockGenerationTargetTime: 15 * time.Second,
+			Signer:                    signer,
+			RenewBefore:               time.Hour,
+			RenewalDuration:           Duration(1000),
+		})
+		assert.Nil(t, err)
+
+		warnings, err := monitor.Warnings(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, warnings, 1)
+		assert.Nil(t, warnings[0].RenewalErr)
+		assert.NotNil(t, warnings[0].Renewal)
+		assert.Equal(t, RegisterNamespace, warnings[0].Renewal.EntityType)
+
+		warnings, err = monitor.Warnings(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, warnings, 0)
+	})
+
+	t.Run("renew namespace by default lead time", func(t *testing.T) {
+		monitor, err := NewExpiryMonitor(client, &ExpiryMonitorConfig{
+			Owners:                    []*Address{owner},
+			BlockGenerationTargetTime: 15 * time.Second,
+			Signer:                    signer,
+			RenewalDuration:           Duration(1000),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, DefaultRenewBefore, monitor.conf.RenewBefore)
+
+		warnings, err := monitor.Warnings(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, warnings, 1)
+		assert.NotNil(t, warnings[0].Renewal)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewExpiryMonitor(client, &ExpiryMonitorConfig{})
+		assert.Equal(t, ErrEmptyExpiryTargets, err)
+
+		_, err = NewExpiryMonitor(client, &ExpiryMonitorConfig{Owners: []*Address{owner}, Signer: signer})
+		assert.Equal(t, ErrZeroRenewalDuration, err)
+	})
+}
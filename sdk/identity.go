@@ -0,0 +1,298 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// results of node verification older than this are verified again before node is used
+const DefaultIdentityMaxAge = 10 * time.Minute
+
+// NetworkIdentity pins generation hash and network type, which every node of Config's BaseURLs should have
+// client does not announce transactions to nodes, which do not match it, and does not fail over to them
+// client does not create accounts by NewAccount, NewAccountFromPrivateKey or AdaptAccount if generation hash or network type
+// of Config differs from pinned ones, so they sign only for pinned network, accounts created without client are not checked
+// node is verified again before it is used if result of its last verification is older than `MaxAge`, results never expire if it is zero
+type NetworkIdentity struct {
+	GenerationHash *Hash
+	NetworkType    NetworkType
+	MaxAge         time.Duration
+
+	mu    sync.RWMutex
+	nodes map[string]*NodeIdentity
+}
+
+// NodeIdentity is a result of the last verification of node
+// `Err` is ErrNetworkIdentityMismatch if node belongs to other network, or error of request if node was not verified
+type NodeIdentity struct {
+	Url            *url.URL
+	GenerationHash *Hash
+	NetworkType    NetworkType
+	VerifiedAt     time.Time
+	Err            error
+}
+
+// returns true if node was verified and it matches pinned network identity
+func (n *NodeIdentity) Matches() bool {
+	return n.Err == nil
+}
+
+// returns true if node was verified and it belongs to other network
+func (n *NodeIdentity) Mismatches() bool {
+	return errors.Is(n.Err, ErrNetworkIdentityMismatch)
+}
+
+// returns NetworkIdentity, which pins passed generation hash and network type, its MaxAge is DefaultIdentityMaxAge
+func NewNetworkIdentity(generationHash *Hash, networkType NetworkType) (*NetworkIdentity, error) {
+	if generationHash == nil || networkType == NotSupportedNet {
+		return nil, ErrIncompleteNetworkIdentity
+	}
+
+	return &NetworkIdentity{
+		GenerationHash: generationHash,
+		NetworkType:    networkType,
+		MaxAge:         DefaultIdentityMaxAge,
+		nodes:          make(map[string]*NodeIdentity),
+	}, nil
+}
+
+// returns config for HTTP Client with pinned network identity, every node of passed urls is verified against it
+// it fails if no node matches, the first matching node becomes used
+func NewPinnedConfig(ctx context.Context, baseUrls []string, generationHash *Hash, networkType NetworkType) (*Config, error) {
+	identity, err := NewNetworkIdentity(generationHash, networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := NewConfigWithReputation(
+		baseUrls,
+		networkType,
+		&defaultRepConfig,
+		DefaultWebsocketReconnectionTimeout,
+		generationHash,
+		DefaultFeeCalculationStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	conf.Identity = identity
+
+	if _, err := NewClient(nil, conf).VerifyNodes(ctx); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// returns result of the last verification of node with passed url, it is nil if node was not verified yet
+func (i *NetworkIdentity) Node(u *url.URL) *NodeIdentity {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.nodes[u.String()]
+}
+
+func (i *NetworkIdentity) setNode(node *NodeIdentity) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.nodes == nil {
+		i.nodes = make(map[string]*NodeIdentity)
+	}
+
+	i.nodes[node.Url.String()] = node
+}
+
+// returns true if result of verification of node is too old to be trusted
+func (i *NetworkIdentity) expired(node *NodeIdentity) bool {
+	return i.MaxAge > 0 && time.Since(node.VerifiedAt) > i.MaxAge
+}
+
+// returns ErrNetworkIdentityMismatch with description of difference if node does not match pinned identity
+func (i *NetworkIdentity) check(node *NodeIdentity) error {
+	if node.GenerationHash == nil || !i.GenerationHash.Equal(node.GenerationHash) {
+		return fmt.Errorf("%w: node %s has generation hash %v instead of %s", ErrNetworkIdentityMismatch, node.Url, node.GenerationHash, i.GenerationHash)
+	}
+
+	if node.NetworkType != i.NetworkType {
+		return fmt.Errorf("%w: node %s has network type %s instead of %s", ErrNetworkIdentityMismatch, node.Url, node.NetworkType, i.NetworkType)
+	}
+
+	return nil
+}
+
+// returns ErrNetworkIdentityMismatch if generation hash or network type of Config differs from pinned network identity
+func (c *Client) checkConfigIdentity() error {
+	if c.config == nil || c.config.Identity == nil {
+		return nil
+	}
+
+	identity := c.config.Identity
+
+	if c.config.GenerationHash == nil || !identity.GenerationHash.Equal(c.config.GenerationHash) {
+		return fmt.Errorf("%w: config has generation hash %v instead of %s", ErrNetworkIdentityMismatch, c.config.GenerationHash, identity.GenerationHash)
+	}
+
+	if c.config.NetworkType != identity.NetworkType {
+		return fmt.Errorf("%w: config has network type %s instead of %s", ErrNetworkIdentityMismatch, c.config.NetworkType, identity.NetworkType)
+	}
+
+	return nil
+}
+
+// verifies every node of BaseURLs against pinned network identity and returns results in the same order
+// if used node does not match, the first matching node becomes used
+// error is returned if no node matches, it is ErrNetworkIdentityMismatch if some node belongs to other network
+func (c *Client) VerifyNodes(ctx context.Context) ([]*NodeIdentity, error) {
+	if c.config.Identity == nil {
+		return nil, ErrNoNetworkIdentity
+	}
+
+	nodes := make([]*NodeIdentity, 0, len(c.config.BaseURLs))
+	var matching *NodeIdentity
+
+	for _, u := range c.config.BaseURLs {
+		node := c.verifyNode(ctx, u)
+		nodes = append(nodes, node)
+
+		if matching == nil && node.Matches() {
+			matching = node
+		}
+
+		if ctx.Err() != nil {
+			return nodes, contextError(ctx)
+		}
+	}
+
+	if matching == nil {
+		for _, node := range nodes {
+			if node.Mismatches() {
+				return nodes, node.Err
+			}
+		}
+
+		return nodes, nodes[0].Err
+	}
+
+	if used := c.config.Identity.Node(c.config.GetUsedBaseUrl()); used == nil || !used.Matches() {
+		c.config.SetUsedBaseUrl(matching.Url)
+	}
+
+	return nodes, nil
+}
+
+// verifies nodes periodically with passed interval until context is done, the first verification is done immediately
+// `handler` receives results of every verification, `errHandler` receives errors of VerifyNodes if it is not nil
+func (c *Client) MonitorNetworkIdentity(ctx context.Context, interval time.Duration, handler func([]*NodeIdentity), errHandler func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		nodes, err := c.VerifyNodes(ctx)
+		if err != nil && ctx.Err() == nil {
+			if errHandler != nil {
+				errHandler(err)
+			}
+		}
+
+		if handler != nil && ctx.Err() == nil {
+			handler(nodes)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// requests generation hash and network type of node with passed url and checks them against pinned network identity
+// result is remembered by identity
+func (c *Client) verifyNode(ctx context.Context, u *url.URL) *NodeIdentity {
+	// requests are sent only to verified node
+	conf := c.config.copy()
+	conf.BaseURLs = []*url.URL{u}
+	conf.UsedBaseUrl = u
+	conf.Retry = nil
+	conf.Identity = nil
+
+	client := NewClient(c.client, &conf)
+	client.middlewares = c.middlewares
+	client.observer = c.observer
+
+	node := &NodeIdentity{Url: u}
+
+	block, err := client.Blockchain.GetBlockByHeight(ctx, Height(1))
+	if err == nil {
+		node.GenerationHash = block.GenerationHash
+		node.NetworkType, err = client.Network.GetNetworkType(ctx)
+	}
+
+	if err == nil {
+		err = c.config.Identity.check(node)
+	}
+
+	node.Err = err
+	node.VerifiedAt = time.Now()
+	c.config.Identity.setNode(node)
+
+	return node
+}
+
+// returns error if node with passed url does not match pinned network identity
+// node is verified if it was not verified yet, the last verification failed because of request error or it is expired
+func (c *Client) checkNodeIdentity(ctx context.Context, u *url.URL) error {
+	if c.config == nil || c.config.Identity == nil {
+		return nil
+	}
+
+	node := c.config.Identity.Node(u)
+	if node == nil || (!node.Matches() && !node.Mismatches()) || c.config.Identity.expired(node) {
+		node = c.verifyNode(ctx, u)
+	}
+
+	return node.Err
+}
+
+// makes sure that used node matches pinned network identity, otherwise the first matching node of BaseURLs becomes used
+// error of used node is returned if no node matches, mismatch of any node is preferred to request errors
+func (c *Client) useVerifiedNode(ctx context.Context) error {
+	used := c.config.GetUsedBaseUrl()
+
+	err := c.checkNodeIdentity(ctx, used)
+	if err == nil {
+		return nil
+	}
+
+	for _, u := range c.config.BaseURLs {
+		if u == used {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return contextError(ctx)
+		}
+
+		nodeErr := c.checkNodeIdentity(ctx, u)
+		if nodeErr == nil {
+			c.config.SetUsedBaseUrl(u)
+			return nil
+		}
+
+		if !errors.Is(err, ErrNetworkIdentityMismatch) && errors.Is(nodeErr, ErrNetworkIdentityMismatch) {
+			err = nodeErr
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,177 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-utils/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+// returns mock of node, which has genesis block of blockInfoJSON and network type from passed response
+func newIdentityMock(networkRouteBody string) *sdkMock {
+	server := newSdkMockWithRouter(&mock.Router{
+		Path:     fmt.Sprintf(blockByHeightRoute, Height(1)),
+		RespBody: blockInfoJSON,
+	})
+	server.AddRouter(&mock.Router{
+		Path:     networkRoute,
+		RespBody: networkRouteBody,
+	})
+	server.AddRouter(&mock.Router{
+		Path:                transactionsRoute,
+		AcceptedHttpMethods: []string{"PUT"},
+		RespBody:            `{"message": "packet 9 was pushed to the network via /transaction"}`,
+	})
+
+	return server
+}
+
+func newPinnedTestClient(t *testing.T, urls ...string) *Client {
+	conf, err := NewConfigWithReputation(urls, MijinTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, wantBlockInfo.GenerationHash, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	conf.Identity, err = NewNetworkIdentity(wantBlockInfo.GenerationHash, MijinTest)
+	assert.Nil(t, err)
+
+	return NewClient(nil, conf)
+}
+
+func TestClient_VerifyNodes(t *testing.T) {
+	good := newIdentityMock(mijinTestRoute)
+	defer good.Close()
+
+	other := newIdentityMock(mijinRoute)
+	defer other.Close()
+
+	client := newPinnedTestClient(t, other.GetServerURL(), good.GetServerURL())
+
+	nodes, err := client.VerifyNodes(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, nodes, 2)
+
+	assert.True(t, nodes[0].Mismatches())
+	assert.Equal(t, Mijin, nodes[0].NetworkType)
+	assert.Contains(t, nodes[0].Err.Error(), "network type")
+
+	assert.True(t, nodes[1].Matches())
+	assert.Equal(t, wantBlockInfo.GenerationHash, nodes[1].GenerationHash)
+	assert.Equal(t, client.config.BaseURLs[1], client.config.GetUsedBaseUrl())
+	assert.Equal(t, nodes[1], client.config.Identity.Node(client.config.GetUsedBaseUrl()))
+
+	client = newPinnedTestClient(t, other.GetServerURL())
+	_, err = client.VerifyNodes(context.Background())
+	assert.True(t, errors.Is(err, ErrNetworkIdentityMismatch))
+
+	_, err = NewClient(nil, &Config{}).VerifyNodes(context.Background())
+	assert.Equal(t, ErrNoNetworkIdentity, err)
+}
+
+func TestTransactionService_Announce_PinnedIdentity(t *testing.T) {
+	good := newIdentityMock(mijinTestRoute)
+	defer good.Close()
+
+	other := newIdentityMock(mijinRoute)
+	defer other.Close()
+
+	tx := &SignedTransaction{Transfer, "AB", &Hash{1}}
+
+	client := newPinnedTestClient(t, other.GetServerURL())
+	_, err := client.Transaction.Announce(context.Background(), tx)
+	assert.True(t, errors.Is(err, ErrNetworkIdentityMismatch), "%v", err)
+
+	client = newPinnedTestClient(t, other.GetServerURL(), good.GetServerURL())
+	_, err = client.Transaction.Announce(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, client.config.BaseURLs[1], client.config.GetUsedBaseUrl())
+}
+
+func TestClient_VerifyNodes_ConcurrentRequests(t *testing.T) {
+	good := newIdentityMock(mijinTestRoute)
+	defer good.Close()
+
+	other := newIdentityMock(mijinRoute)
+	defer other.Close()
+
+	client := newPinnedTestClient(t, other.GetServerURL(), good.GetServerURL())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		// every verification switches client back from mismatching node
+		for i := 0; i < 20; i++ {
+			client.config.SetUsedBaseUrl(client.config.BaseURLs[0])
+			_, err := client.VerifyNodes(context.Background())
+			assert.Nil(t, err)
+		}
+	}()
+
+	tx := &SignedTransaction{Transfer, "AB", &Hash{1}}
+	for i := 0; i < 20; i++ {
+		_, err := client.Transaction.Announce(context.Background(), tx)
+		assert.Nil(t, err)
+	}
+
+	<-done
+	assert.Equal(t, client.config.BaseURLs[1], client.config.GetUsedBaseUrl())
+}
+
+func TestClient_AdaptAccount_PinnedIdentity(t *testing.T) {
+	account, err := NewAccount(MijinTest, wantBlockInfo.GenerationHash)
+	assert.Nil(t, err)
+
+	client := newPinnedTestClient(t, "http://localhost:3000")
+	_, err = client.AdaptAccount(account)
+	assert.Nil(t, err)
+
+	client.config.GenerationHash = &Hash{1}
+	_, err = client.AdaptAccount(account)
+	assert.True(t, errors.Is(err, ErrNetworkIdentityMismatch), "%v", err)
+
+	client.config.GenerationHash = wantBlockInfo.GenerationHash
+	client.config.NetworkType = Mijin
+	_, err = client.NewAccount()
+	assert.True(t, errors.Is(err, ErrNetworkIdentityMismatch), "%v", err)
+}
+
+func TestClient_checkNodeIdentity_Expired(t *testing.T) {
+	other := newIdentityMock(mijinRoute)
+	defer other.Close()
+
+	client := newPinnedTestClient(t, other.GetServerURL())
+	u := client.config.GetUsedBaseUrl()
+	identity := client.config.Identity
+
+	// node matched before, but it was moved to other network since then
+	identity.setNode(&NodeIdentity{Url: u, GenerationHash: identity.GenerationHash, NetworkType: identity.NetworkType, VerifiedAt: time.Now()})
+	assert.Nil(t, client.checkNodeIdentity(context.Background(), u))
+
+	identity.setNode(&NodeIdentity{Url: u, GenerationHash: identity.GenerationHash, NetworkType: identity.NetworkType, VerifiedAt: time.Now().Add(-2 * identity.MaxAge)})
+	err := client.checkNodeIdentity(context.Background(), u)
+	assert.True(t, errors.Is(err, ErrNetworkIdentityMismatch), "%v", err)
+	assert.True(t, identity.Node(u).Mismatches())
+}
+
+func TestNewPinnedConfig(t *testing.T) {
+	good := newIdentityMock(mijinTestRoute)
+	defer good.Close()
+
+	conf, err := NewPinnedConfig(context.Background(), []string{good.GetServerURL()}, wantBlockInfo.GenerationHash, MijinTest)
+	assert.Nil(t, err)
+	assert.Equal(t, MijinTest, conf.NetworkType)
+	assert.Equal(t, wantBlockInfo.GenerationHash, conf.GenerationHash)
+
+	_, err = NewPinnedConfig(context.Background(), []string{good.GetServerURL()}, &Hash{1}, MijinTest)
+	assert.True(t, errors.Is(err, ErrNetworkIdentityMismatch))
+
+	_, err = NewPinnedConfig(context.Background(), []string{good.GetServerURL()}, nil, MijinTest)
+	assert.Equal(t, ErrIncompleteNetworkIdentity, err)
+}
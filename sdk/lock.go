@@ -0,0 +1,147 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/proximax-storage/go-xpx-utils/net"
+)
+
+type LockService service
+
+// returns HashLockInfo's of locks created by account with passed Address
+func (ref *LockService) GetHashLockInfosByAccount(ctx context.Context, address *Address) ([]*HashLockInfo, error) {
+	if address == nil {
+		return nil, ErrNilAddress
+	}
+
+	url := net.NewUrl(fmt.Sprintf(hashLocksByAccountRoute, address.Address))
+
+	dtos := hashLockInfoDTOs(make([]*hashLockInfoDTO, 0))
+
+	resp, err := ref.client.doNewRequest(ctx, http.MethodGet, url.Encode(), nil, &dtos)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = handleResponseStatusCode(resp, map[int]error{404: ErrResourceNotFound, 409: ErrArgumentNotValid}); err != nil {
+		return nil, err
+	}
+
+	return dtos.toStruct(ref.client.config.NetworkType)
+}
+
+// returns HashLockInfo of lock for AggregateBonded transaction with passed hash
+func (ref *LockService) GetHashLockInfo(ctx context.Context, hash *Hash) (*HashLockInfo, error) {
+	if hash == nil {
+		return nil, ErrNilHash
+	}
+
+	url := net.NewUrl(fmt.Sprintf(hashLockRoute, hash))
+
+	dto := &hashLockInfoDTO{}
+
+	resp, err := ref.client.doNewRequest(ctx, http.MethodGet, url.Encode(), nil, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = handleResponseStatusCode(resp, map[int]error{404: ErrResourceNotFound, 409: ErrArgumentNotValid}); err != nil {
+		return nil, err
+	}
+
+	return dto.toStruct(ref.client.config.NetworkType)
+}
+
+// returns true if hash lock for AggregateBonded transaction with passed hash exists and is still active at current chain height
+func (ref *LockService) IsHashLockActive(ctx context.Context, hash *Hash) (bool, error) {
+	info, err := ref.GetHashLockInfo(ctx, hash)
+	if err != nil {
+		if e, ok := err.(*HttpError); ok && e.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	height, err := ref.client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return info.IsActive(height), nil
+}
+
+// returns SecretLockInfo's of locks created by account with passed Address
+func (ref *LockService) GetSecretLockInfosByAccount(ctx context.Context, address *Address) ([]*SecretLockInfo, error) {
+	if address == nil {
+		return nil, ErrNilAddress
+	}
+
+	url := net.NewUrl(fmt.Sprintf(secretLocksByAccountRoute, address.Address))
+
+	return ref.getSecretLockInfos(ctx, url.Encode())
+}
+
+// returns SecretLockInfo's of locks with passed Secret for all recipients
+func (ref *LockService) GetSecretLockInfosBySecret(ctx context.Context, secret *Secret) ([]*SecretLockInfo, error) {
+	if secret == nil {
+		return nil, ErrNilSecret
+	}
+
+	url := net.NewUrl(fmt.Sprintf(secretLocksBySecretRoute, secret.HashString()))
+
+	return ref.getSecretLockInfos(ctx, url.Encode())
+}
+
+// returns SecretLockInfo by composite hash of lock calculated by CalculateSecretLockInfoHash
+func (ref *LockService) GetSecretLockInfo(ctx context.Context, compositeHash *Hash) (*SecretLockInfo, error) {
+	if compositeHash == nil {
+		return nil, ErrNilHash
+	}
+
+	url := net.NewUrl(fmt.Sprintf(secretLockByCompositeRoute, compositeHash))
+
+	dto := &secretLockInfoDTO{}
+
+	resp, err := ref.client.doNewRequest(ctx, http.MethodGet, url.Encode(), nil, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = handleResponseStatusCode(resp, map[int]error{404: ErrResourceNotFound, 409: ErrArgumentNotValid}); err != nil {
+		return nil, err
+	}
+
+	return dto.toStruct(ref.client.config.NetworkType)
+}
+
+// returns SecretLockInfo of lock with passed Secret for passed recipient Address
+func (ref *LockService) GetSecretLockInfoByRecipient(ctx context.Context, secret *Secret, recipient *Address) (*SecretLockInfo, error) {
+	compositeHash, err := CalculateSecretLockInfoHash(secret, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.GetSecretLockInfo(ctx, compositeHash)
+}
+
+func (ref *LockService) getSecretLockInfos(ctx context.Context, path string) ([]*SecretLockInfo, error) {
+	dtos := secretLockInfoDTOs(make([]*secretLockInfoDTO, 0))
+
+	resp, err := ref.client.doNewRequest(ctx, http.MethodGet, path, nil, &dtos)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = handleResponseStatusCode(resp, map[int]error{404: ErrResourceNotFound, 409: ErrArgumentNotValid}); err != nil {
+		return nil, err
+	}
+
+	return dtos.toStruct(ref.client.config.NetworkType)
+}
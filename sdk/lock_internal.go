@@ -0,0 +1,150 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+type lockInfoDTO struct {
+	Account  string      `json:"account"`
+	MosaicId mosaicIdDTO `json:"mosaicId"`
+	Amount   uint64DTO   `json:"amount"`
+	Height   uint64DTO   `json:"height"`
+	Status   LockStatus  `json:"status"`
+}
+
+func (ref *lockInfoDTO) toStruct(networkType NetworkType) (*PublicAccount, *Mosaic, error) {
+	account, err := NewAccountFromPublicKey(ref.Account, networkType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mosaicId, err := ref.MosaicId.toStruct()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mosaic, err := NewMosaic(mosaicId, ref.Amount.toStruct())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, mosaic, nil
+}
+
+type hashLockInfoDTO struct {
+	Lock struct {
+		lockInfoDTO
+		Hash hashDto `json:"hash"`
+	} `json:"lock"`
+}
+
+func (ref *hashLockInfoDTO) toStruct(networkType NetworkType) (*HashLockInfo, error) {
+	account, mosaic, err := ref.Lock.lockInfoDTO.toStruct(networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := ref.Lock.Hash.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return &HashLockInfo{
+		Account:   account,
+		Mosaic:    mosaic,
+		EndHeight: ref.Lock.Height.toStruct(),
+		Status:    ref.Lock.Status,
+		Hash:      hash,
+	}, nil
+}
+
+type hashLockInfoDTOs []*hashLockInfoDTO
+
+func (ref *hashLockInfoDTOs) toStruct(networkType NetworkType) ([]*HashLockInfo, error) {
+	var (
+		dtos  = *ref
+		infos = make([]*HashLockInfo, 0, len(dtos))
+	)
+
+	for _, dto := range dtos {
+		info, err := dto.toStruct(networkType)
+		if err != nil {
+			return nil, err
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+type secretLockInfoDTO struct {
+	Lock struct {
+		lockInfoDTO
+		HashAlgorithm HashType `json:"hashAlgorithm"`
+		Secret        hashDto  `json:"secret"`
+		Recipient     string   `json:"recipient"`
+		CompositeHash hashDto  `json:"compositeHash"`
+	} `json:"lock"`
+}
+
+func (ref *secretLockInfoDTO) toStruct(networkType NetworkType) (*SecretLockInfo, error) {
+	account, mosaic, err := ref.Lock.lockInfoDTO.toStruct(networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	secretHash, err := ref.Lock.Secret.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	if secretHash == nil {
+		return nil, ErrNilSecret
+	}
+
+	secret, err := NewSecret(secretHash[:], ref.Lock.HashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := NewAddressFromBase32(ref.Lock.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	compositeHash, err := ref.Lock.CompositeHash.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretLockInfo{
+		Account:       account,
+		Mosaic:        mosaic,
+		EndHeight:     ref.Lock.Height.toStruct(),
+		Status:        ref.Lock.Status,
+		Secret:        secret,
+		Recipient:     recipient,
+		CompositeHash: compositeHash,
+	}, nil
+}
+
+type secretLockInfoDTOs []*secretLockInfoDTO
+
+func (ref *secretLockInfoDTOs) toStruct(networkType NetworkType) ([]*SecretLockInfo, error) {
+	var (
+		dtos  = *ref
+		infos = make([]*SecretLockInfo, 0, len(dtos))
+	)
+
+	for _, dto := range dtos {
+		info, err := dto.toStruct(networkType)
+		if err != nil {
+			return nil, err
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/proximax-storage/go-xpx-utils/str"
+)
+
+type LockStatus uint8
+
+const (
+	Unused LockStatus = iota
+	Used
+)
+
+func (s LockStatus) String() string {
+	switch s {
+	case Unused:
+		return "Unused"
+	case Used:
+		return "Used"
+	}
+
+	return fmt.Sprintf("%d", s)
+}
+
+// HashLockInfo describes mosaic locked by LockFundsTransaction for announced AggregateBonded transaction
+// `EndHeight` is the height, when the lock expires
+// `Status` is Used after aggregate is confirmed or lock is expired
+type HashLockInfo struct {
+	Account   *PublicAccount
+	Mosaic    *Mosaic
+	EndHeight Height
+	Status    LockStatus
+	Hash      *Hash
+}
+
+// returns true if lock is not used and not expired at passed height
+func (ref *HashLockInfo) IsActive(height Height) bool {
+	return ref.Status == Unused && uint64(height) < uint64(ref.EndHeight)
+}
+
+func (ref *HashLockInfo) String() string {
+	return str.StructToString(
+		"HashLockInfo",
+		str.NewField("Account", str.StringPattern, ref.Account),
+		str.NewField("Mosaic", str.StringPattern, ref.Mosaic),
+		str.NewField("EndHeight", str.StringPattern, ref.EndHeight),
+		str.NewField("Status", str.StringPattern, ref.Status),
+		str.NewField("Hash", str.StringPattern, ref.Hash),
+	)
+}
+
+// SecretLockInfo describes mosaic locked by SecretLockTransaction
+// `CompositeHash` identifies lock, it is calculated by CalculateSecretLockInfoHash from secret and recipient
+// `Status` is Used after lock is proven or expired
+type SecretLockInfo struct {
+	Account       *PublicAccount
+	Mosaic        *Mosaic
+	EndHeight     Height
+	Status        LockStatus
+	Secret        *Secret
+	Recipient     *Address
+	CompositeHash *Hash
+}
+
+// returns true if lock is not used and not expired at passed height
+func (ref *SecretLockInfo) IsActive(height Height) bool {
+	return ref.Status == Unused && uint64(height) < uint64(ref.EndHeight)
+}
+
+// returns true if lock is used before it's expiration at passed height, so it was proven by recipient
+func (ref *SecretLockInfo) IsProven(height Height) bool {
+	return ref.Status == Used && uint64(height) < uint64(ref.EndHeight)
+}
+
+func (ref *SecretLockInfo) String() string {
+	return str.StructToString(
+		"SecretLockInfo",
+		str.NewField("Account", str.StringPattern, ref.Account),
+		str.NewField("Mosaic", str.StringPattern, ref.Mosaic),
+		str.NewField("EndHeight", str.StringPattern, ref.EndHeight),
+		str.NewField("Status", str.StringPattern, ref.Status),
+		str.NewField("Secret", str.StringPattern, ref.Secret),
+		str.NewField("Recipient", str.StringPattern, ref.Recipient),
+		str.NewField("CompositeHash", str.StringPattern, ref.CompositeHash),
+	)
+}
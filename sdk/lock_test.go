@@ -0,0 +1,151 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/proximax-storage/go-xpx-utils/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testLockAccountPublicKey = "321DE652C4D3362FC2DDF7800F6582F4A10CFEA134B81F8AB6E4BE78BBA4D18E"
+	testLockRecipient        = "901CD938C5CE4ED22031C5CE398E618EB1205D5344E2539B58"
+	testHashLockHash         = "D8E06B597BEE34263E9C970A50B5341783EFF67EF00637644C114447BE1905DA"
+)
+
+var testHashLockInfoJson = fmt.Sprintf(`{
+	"meta": {
+		"id": "5d9c4d5ca4a7c1000145b0a8"
+	},
+	"lock": {
+		"account": "%s",
+		"accountAddress": "90FD35818960C7B18B72F49A5598FA9F712A354DB38EB076C4",
+		"mosaicId": [519256100, 642862634],
+		"amount": [10000000, 0],
+		"height": [1000, 0],
+		"status": 0,
+		"hash": "%s"
+	}
+}`, testLockAccountPublicKey, testHashLockHash)
+
+func testSecretLockInfoJson(t *testing.T) (string, *Secret, *Hash) {
+	secret, err := NewProofFromString("lock proof").Secret(SHA3_256)
+	assert.Nil(t, err)
+
+	recipient, err := NewAddressFromBase32(testLockRecipient)
+	assert.Nil(t, err)
+
+	compositeHash, err := CalculateSecretLockInfoHash(secret, recipient)
+	assert.Nil(t, err)
+
+	return fmt.Sprintf(`{
+	"meta": {
+		"id": "5d9c4d5ca4a7c1000145b0a9"
+	},
+	"lock": {
+		"account": "%s",
+		"accountAddress": "90FD35818960C7B18B72F49A5598FA9F712A354DB38EB076C4",
+		"mosaicId": [519256100, 642862634],
+		"amount": [10000000, 0],
+		"height": [1000, 0],
+		"status": 1,
+		"hashAlgorithm": 0,
+		"secret": "%s",
+		"recipient": "%s",
+		"compositeHash": "%s"
+	}
+}`, testLockAccountPublicKey, secret.HashString(), testLockRecipient, compositeHash), secret, compositeHash
+}
+
+func TestLockService_GetHashLockInfo(t *testing.T) {
+	hash, err := StringToHash(testHashLockHash)
+	assert.Nil(t, err)
+
+	mockServ := newSdkMockWithRouter(&mock.Router{
+		Path:     fmt.Sprintf(hashLockRoute, hash),
+		RespBody: testHashLockInfoJson,
+	})
+	defer mockServ.Close()
+
+	mockServ.AddRouter(&mock.Router{
+		Path:     fmt.Sprintf(hashLocksByAccountRoute, nemTestAddress1),
+		RespBody: "[" + testHashLockInfoJson + "]",
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     blockHeightRoute,
+		RespBody: `{"height": [990, 0]}`,
+	})
+
+	lockService := mockServ.getPublicTestClientUnsafe().Lock
+
+	info, err := lockService.GetHashLockInfo(ctx, hash)
+	assert.Nilf(t, err, "LockService.GetHashLockInfo returned error: %s", err)
+	assert.Equal(t, testLockAccountPublicKey, info.Account.PublicKey)
+	assert.Equal(t, Amount(10000000), info.Mosaic.Amount)
+	assert.Equal(t, Height(1000), info.EndHeight)
+	assert.Equal(t, Unused, info.Status)
+	assert.Equal(t, hash, info.Hash)
+	assert.True(t, info.IsActive(Height(990)))
+	assert.False(t, info.IsActive(Height(1000)))
+
+	infos, err := lockService.GetHashLockInfosByAccount(ctx, NewAddress(nemTestAddress1, PublicTest))
+	assert.Nil(t, err)
+	assert.Equal(t, []*HashLockInfo{info}, infos)
+
+	active, err := lockService.IsHashLockActive(ctx, hash)
+	assert.Nil(t, err)
+	assert.True(t, active)
+
+	active, err = lockService.IsHashLockActive(ctx, &Hash{1})
+	assert.Nil(t, err)
+	assert.False(t, active)
+
+	_, err = lockService.GetHashLockInfo(ctx, nil)
+	assert.Equal(t, ErrNilHash, err)
+}
+
+func TestLockService_GetSecretLockInfo(t *testing.T) {
+	lockJson, secret, compositeHash := testSecretLockInfoJson(t)
+
+	mockServ := newSdkMockWithRouter(&mock.Router{
+		Path:     fmt.Sprintf(secretLockByCompositeRoute, compositeHash),
+		RespBody: lockJson,
+	})
+	defer mockServ.Close()
+
+	mockServ.AddRouter(&mock.Router{
+		Path:     fmt.Sprintf(secretLocksBySecretRoute, secret.HashString()),
+		RespBody: "[" + lockJson + "]",
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     fmt.Sprintf(secretLocksByAccountRoute, nemTestAddress1),
+		RespBody: "[" + lockJson + "]",
+	})
+
+	lockService := mockServ.getPublicTestClientUnsafe().Lock
+
+	recipient, err := NewAddressFromBase32(testLockRecipient)
+	assert.Nil(t, err)
+
+	info, err := lockService.GetSecretLockInfoByRecipient(ctx, secret, recipient)
+	assert.Nilf(t, err, "LockService.GetSecretLockInfoByRecipient returned error: %s", err)
+	assert.Equal(t, secret, info.Secret)
+	assert.Equal(t, recipient, info.Recipient)
+	assert.Equal(t, compositeHash, info.CompositeHash)
+	assert.Equal(t, Used, info.Status)
+	assert.True(t, info.IsProven(Height(990)))
+	assert.False(t, info.IsActive(Height(990)))
+
+	infos, err := lockService.GetSecretLockInfosBySecret(ctx, secret)
+	assert.Nil(t, err)
+	assert.Equal(t, []*SecretLockInfo{info}, infos)
+
+	infos, err = lockService.GetSecretLockInfosByAccount(ctx, NewAddress(nemTestAddress1, PublicTest))
+	assert.Nil(t, err)
+	assert.Equal(t, []*SecretLockInfo{info}, infos)
+}
@@ -0,0 +1,265 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Package metrics collects events of REST and websocket clients and serves them in Prometheus text format.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets are upper bounds of latency histograms in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Exporter implements sdk.Observer and websocket.Observer, collected metrics are served by ServeHTTP
+// it should be passed to SetObserver of sdk.Client and of websocket clients
+type Exporter struct {
+	mu sync.Mutex
+
+	requests        *counterVec
+	requestDuration *histogramVec
+	bytesSent       *counterVec
+	bytesReceived   *counterVec
+	retries         *counterVec
+	failovers       *counterVec
+
+	messages        *counterVec
+	messageBytes    *counterVec
+	messageDuration *histogramVec
+	connections     *counterVec
+}
+
+// returns exporter, which histograms have DefaultBuckets
+func NewExporter() *Exporter {
+	return &Exporter{
+		requests:        newCounterVec("xpx_http_requests_total", "Number of REST requests.", "method", "route", "code"),
+		requestDuration: newHistogramVec("xpx_http_request_duration_seconds", "Latency of REST requests.", DefaultBuckets, "method", "route"),
+		bytesSent:       newCounterVec("xpx_http_sent_bytes_total", "Size of REST request bodies.", "method", "route"),
+		bytesReceived:   newCounterVec("xpx_http_received_bytes_total", "Size of REST response bodies.", "method", "route"),
+		retries:         newCounterVec("xpx_http_retries_total", "Number of REST requests retried on another node.", "node"),
+		failovers:       newCounterVec("xpx_http_failovers_total", "Number of switches of REST client to another node.", "node"),
+
+		messages:        newCounterVec("xpx_ws_messages_total", "Number of received websocket messages.", "topic"),
+		messageBytes:    newCounterVec("xpx_ws_received_bytes_total", "Size of received websocket messages.", "topic"),
+		messageDuration: newHistogramVec("xpx_ws_handle_duration_seconds", "Time spent by handlers of websocket messages.", DefaultBuckets, "topic"),
+		connections:     newCounterVec("xpx_ws_connection_state_changes_total", "Number of websocket connection state changes, Reconnecting ones are reconnects.", "state"),
+	}
+}
+
+func (e *Exporter) ObserveRequest(info *sdk.RequestInfo) {
+	code := "error"
+	if info.StatusCode != 0 {
+		code = strconv.Itoa(info.StatusCode)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requests.add(1, info.Method, info.Route, code)
+	e.requestDuration.observe(info.Duration, info.Method, info.Route)
+	e.bytesSent.add(float64(info.BytesSent), info.Method, info.Route)
+	e.bytesReceived.add(float64(info.BytesReceived), info.Method, info.Route)
+}
+
+func (e *Exporter) ObserveRetry(_, to *url.URL, _ error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.retries.add(1, to.Host)
+}
+
+func (e *Exporter) ObserveFailover(_, to *url.URL) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failovers.add(1, to.Host)
+}
+
+func (e *Exporter) ObserveMessage(path websocket.Path, size int, elapsed time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.messages.add(1, string(path))
+	e.messageBytes.add(float64(size), string(path))
+	e.messageDuration.observe(elapsed, string(path))
+}
+
+func (e *Exporter) ObserveConnectionState(state websocket.ConnectionState, _ error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.connections.add(1, state.String())
+}
+
+// writes collected metrics in Prometheus text exposition format
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	b := &bytes.Buffer{}
+
+	e.mu.Lock()
+	e.requests.write(b)
+	e.requestDuration.write(b)
+	e.bytesSent.write(b)
+	e.bytesReceived.write(b)
+	e.retries.write(b)
+	e.failovers.write(b)
+	e.messages.write(b)
+	e.messageBytes.write(b)
+	e.messageDuration.write(b)
+	e.connections.write(b)
+	e.mu.Unlock()
+
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(b.Bytes())
+}
+
+// labeled keeps label values of all series of metric in order of their keys
+type labeled struct {
+	names []string
+	keys  []string
+	// label values by key of series
+	values map[string][]string
+}
+
+func newLabeled(names []string) labeled {
+	return labeled{names: names, values: make(map[string][]string)}
+}
+
+// returns key of series with passed label values, series is registered if it is new
+func (l *labeled) key(values []string) (string, bool) {
+	key := strings.Join(values, "\xff")
+	if _, ok := l.values[key]; ok {
+		return key, false
+	}
+
+	l.values[key] = values
+	l.keys = append(l.keys, key)
+	sort.Strings(l.keys)
+
+	return key, true
+}
+
+// returns labels of series in Prometheus format with passed extra label appended
+func (l *labeled) format(key string, extra ...string) string {
+	pairs := make([]string, 0, len(l.names)+1)
+
+	for i, value := range l.values[key] {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, l.names[i], labelEscaper.Replace(value)))
+	}
+
+	if len(extra) == 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, extra[0], extra[1]))
+	}
+
+	if len(pairs) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+type counterVec struct {
+	labeled
+	name, help string
+	counts     map[string]float64
+}
+
+func newCounterVec(name, help string, labels ...string) *counterVec {
+	return &counterVec{
+		labeled: newLabeled(labels),
+		name:    name,
+		help:    help,
+		counts:  make(map[string]float64),
+	}
+}
+
+func (c *counterVec) add(value float64, labels ...string) {
+	key, _ := c.key(labels)
+	c.counts[key] += value
+}
+
+func (c *counterVec) write(b *bytes.Buffer) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+
+	for _, key := range c.keys {
+		fmt.Fprintf(b, "%s%s %s\n", c.name, c.format(key), formatFloat(c.counts[key]))
+	}
+}
+
+type histogram struct {
+	// cumulative counts by bucket
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+type histogramVec struct {
+	labeled
+	name, help string
+	bounds     []float64
+	histograms map[string]*histogram
+}
+
+func newHistogramVec(name, help string, bounds []float64, labels ...string) *histogramVec {
+	return &histogramVec{
+		labeled:    newLabeled(labels),
+		name:       name,
+		help:       help,
+		bounds:     bounds,
+		histograms: make(map[string]*histogram),
+	}
+}
+
+func (h *histogramVec) observe(d time.Duration, labels ...string) {
+	key, isNew := h.key(labels)
+	if isNew {
+		h.histograms[key] = &histogram{buckets: make([]uint64, len(h.bounds))}
+	}
+
+	hist := h.histograms[key]
+	seconds := d.Seconds()
+
+	for i, bound := range h.bounds {
+		if seconds <= bound {
+			hist.buckets[i]++
+		}
+	}
+
+	hist.count++
+	hist.sum += seconds
+}
+
+func (h *histogramVec) write(b *bytes.Buffer) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+
+	for _, key := range h.keys {
+		hist := h.histograms[key]
+
+		for i, bound := range h.bounds {
+			fmt.Fprintf(b, "%s_bucket%s %d\n", h.name, h.format(key, "le", formatFloat(bound)), hist.buckets[i])
+		}
+
+		fmt.Fprintf(b, "%s_bucket%s %d\n", h.name, h.format(key, "le", "+Inf"), hist.count)
+		fmt.Fprintf(b, "%s_sum%s %s\n", h.name, h.format(key), formatFloat(hist.sum))
+		fmt.Fprintf(b, "%s_count%s %d\n", h.name, h.format(key), hist.count)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket"
+)
+
+var (
+	_ sdk.Observer       = (*Exporter)(nil)
+	_ websocket.Observer = (*Exporter)(nil)
+)
+
+func TestExporter_ServeHTTP(t *testing.T) {
+	e := NewExporter()
+
+	e.ObserveRequest(&sdk.RequestInfo{Method: http.MethodGet, Route: "/block/:id", StatusCode: 200, Duration: 20 * time.Millisecond, BytesReceived: 100})
+	e.ObserveRequest(&sdk.RequestInfo{Method: http.MethodGet, Route: "/block/:id", StatusCode: 200, Duration: 200 * time.Millisecond, BytesReceived: 50})
+	e.ObserveRequest(&sdk.RequestInfo{Method: http.MethodGet, Route: "/block/:id", Err: errors.New("refused")})
+	e.ObserveRetry(&url.URL{Host: "first:3000"}, &url.URL{Host: "second:3000"}, errors.New("refused"))
+	e.ObserveFailover(&url.URL{Host: "first:3000"}, &url.URL{Host: "second:3000"})
+	e.ObserveMessage("block", 300, time.Millisecond)
+	e.ObserveConnectionState(websocket.Reconnecting, errors.New("stale"))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, contentType, rec.Header().Get("Content-Type"))
+
+	for _, line := range []string{
+		"# TYPE xpx_http_requests_total counter",
+		`xpx_http_requests_total{method="GET",route="/block/:id",code="200"} 2`,
+		`xpx_http_requests_total{method="GET",route="/block/:id",code="error"} 1`,
+		"# TYPE xpx_http_request_duration_seconds histogram",
+		`xpx_http_request_duration_seconds_bucket{method="GET",route="/block/:id",le="0.01"} 1`,
+		`xpx_http_request_duration_seconds_bucket{method="GET",route="/block/:id",le="0.025"} 2`,
+		`xpx_http_request_duration_seconds_bucket{method="GET",route="/block/:id",le="0.25"} 3`,
+		`xpx_http_request_duration_seconds_bucket{method="GET",route="/block/:id",le="+Inf"} 3`,
+		`xpx_http_request_duration_seconds_sum{method="GET",route="/block/:id"} 0.22`,
+		`xpx_http_request_duration_seconds_count{method="GET",route="/block/:id"} 3`,
+		`xpx_http_received_bytes_total{method="GET",route="/block/:id"} 150`,
+		`xpx_http_retries_total{node="second:3000"} 1`,
+		`xpx_http_failovers_total{node="second:3000"} 1`,
+		`xpx_ws_messages_total{topic="block"} 1`,
+		`xpx_ws_received_bytes_total{topic="block"} 300`,
+		`xpx_ws_connection_state_changes_total{state="Reconnecting"} 1`,
+	} {
+		assert.Contains(t, strings.Split(rec.Body.String(), "\n"), line)
+	}
+}
+
+func TestLabeled_format(t *testing.T) {
+	l := newLabeled([]string{"topic"})
+	key, isNew := l.key([]string{"a\"b\\c\nd"})
+	assert.True(t, isNew)
+
+	assert.Equal(t, `{topic="a\"b\\c\nd",le="1"}`, l.format(key, "le", "1"))
+}
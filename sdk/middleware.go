@@ -0,0 +1,149 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+const DefaultRequestIdHeader = "X-Request-Id"
+
+// RequestHandler sends request to REST server
+type RequestHandler func(req *http.Request) (*http.Response, error)
+
+// RequestMiddleware wraps sending of every attempt of request, including retries on other nodes
+type RequestMiddleware func(next RequestHandler) RequestHandler
+
+// adds middlewares, which wrap sending of requests, the first middleware is the outermost one
+// it should be called before client is used
+func (c *Client) Use(middlewares ...RequestMiddleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// sends request through middlewares
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	handler := RequestHandler(c.client.Do)
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+
+	return handler(req)
+}
+
+// returns middleware, which calls `f` before request is sent, request is not sent if `f` returns error
+func BeforeRequest(f func(req *http.Request) error) RequestMiddleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			if err := f(req); err != nil {
+				return nil, err
+			}
+
+			return next(req)
+		}
+	}
+}
+
+// returns middleware, which calls `f` after response is received, error of `f` is returned instead of response
+// body of response should not be consumed by `f`
+func AfterResponse(f func(resp *http.Response) error) RequestMiddleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := f(resp); err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+
+			return resp, nil
+		}
+	}
+}
+
+// returns middleware, which sets passed header to API key
+func APIKey(header, key string) RequestMiddleware {
+	return BeforeRequest(func(req *http.Request) error {
+		req.Header.Set(header, key)
+		return nil
+	})
+}
+
+// returns middleware, which authorizes requests by bearer token
+func BearerToken(token string) RequestMiddleware {
+	return BeforeRequest(func(req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// returns middleware, which sets User-Agent header
+func UserAgent(userAgent string) RequestMiddleware {
+	return BeforeRequest(func(req *http.Request) error {
+		req.Header.Set("User-Agent", userAgent)
+		return nil
+	})
+}
+
+// returns middleware, which sets passed header to random id, DefaultRequestIdHeader is used if header is empty
+// id is kept when request is retried on another node
+func RequestId(header string) RequestMiddleware {
+	if header == "" {
+		header = DefaultRequestIdHeader
+	}
+
+	return BeforeRequest(func(req *http.Request) error {
+		if req.Header.Get(header) != "" {
+			return nil
+		}
+
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return err
+		}
+
+		req.Header.Set(header, hex.EncodeToString(id))
+		return nil
+	})
+}
+
+// returns middleware, which writes dumps of requests and responses including bodies to passed writer
+// dumps can contain credentials, so it should be used only for debugging
+func DebugDump(w io.Writer) RequestMiddleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			dump, err := httputil.DumpRequestOut(req, true)
+			if err != nil {
+				return nil, err
+			}
+
+			fmt.Fprintf(w, "%s\n\n", dump)
+
+			resp, err := next(req)
+			if err != nil {
+				fmt.Fprintf(w, "%s %s failed: %s\n\n", req.Method, req.URL, err)
+				return nil, err
+			}
+
+			dump, err = httputil.DumpResponse(resp, true)
+			if err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+
+			fmt.Fprintf(w, "%s\n\n", dump)
+
+			return resp, nil
+		}
+	}
+}
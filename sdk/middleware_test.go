@@ -0,0 +1,78 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Use(t *testing.T) {
+	var header http.Header
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.Header().Set("X-Served-By", "test")
+		_, _ = w.Write([]byte(`{"height": [42, 0]}`))
+	}))
+	defer server.Close()
+
+	conf, err := NewConfigWithReputation([]string{server.URL}, PublicTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, nil, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	dump := &bytes.Buffer{}
+	calls := make([]string, 0)
+
+	client := NewClient(nil, conf)
+	client.Use(
+		BeforeRequest(func(req *http.Request) error {
+			calls = append(calls, "before")
+			return nil
+		}),
+		AfterResponse(func(resp *http.Response) error {
+			calls = append(calls, "after "+resp.Header.Get("X-Served-By"))
+			return nil
+		}),
+		APIKey("X-Api-Key", "key"),
+		BearerToken("token"),
+		UserAgent("xpx-test"),
+		RequestId(""),
+		DebugDump(dump),
+	)
+
+	height, err := client.Blockchain.GetBlockchainHeight(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, Height(42), height)
+
+	assert.Equal(t, []string{"before", "after test"}, calls)
+	assert.Equal(t, "key", header.Get("X-Api-Key"))
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Equal(t, "xpx-test", header.Get("User-Agent"))
+	assert.Len(t, header.Get(DefaultRequestIdHeader), 32)
+
+	assert.True(t, strings.Contains(dump.String(), "GET /chain/height"))
+	assert.True(t, strings.Contains(dump.String(), `{"height": [42, 0]}`))
+}
+
+func TestBeforeRequest_Error(t *testing.T) {
+	conf, err := NewConfigWithReputation([]string{"http://localhost:3000"}, PublicTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, nil, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	expected := errors.New("not authorized")
+
+	client := NewClient(nil, conf)
+	client.Use(BeforeRequest(func(*http.Request) error {
+		return expected
+	}))
+
+	_, err = client.Blockchain.GetBlockchainHeight(ctx)
+	assert.Equal(t, expected, err)
+}
@@ -6,6 +6,8 @@ package sdk
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/proximax-storage/go-xpx-utils/str"
 )
@@ -203,6 +205,23 @@ func (tx MosaicSupplyType) String() string {
 	return fmt.Sprintf("%d", tx)
 }
 
+// returns passed amount formatted with passed divisibility
+// Example: FormatAmount(1500000, 6) => "1.500000"
+func FormatAmount(amount Amount, divisibility uint8) string {
+	raw := strconv.FormatUint(uint64(amount), 10)
+
+	if divisibility == 0 {
+		return raw
+	}
+
+	d := int(divisibility)
+	if len(raw) <= d {
+		raw = strings.Repeat("0", d-len(raw)+1) + raw
+	}
+
+	return raw[:len(raw)-d] + "." + raw[len(raw)-d:]
+}
+
 // returns XEM mosaic with passed amount
 func Xem(amount uint64) *Mosaic {
 	return newMosaicPanic(XemNamespaceId, Amount(amount))
@@ -65,3 +65,11 @@ func TestNewMosaicIdFromNonceAndOwner(t *testing.T) {
 		assert.Equal(t, m.expectedMosaicId, mosaicId.toHexString())
 	}
 }
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "1500000", FormatAmount(1500000, 0))
+	assert.Equal(t, "1.500000", FormatAmount(1500000, 6))
+	assert.Equal(t, "0.000015", FormatAmount(15, 6))
+	assert.Equal(t, "0.000000", FormatAmount(0, 6))
+	assert.Equal(t, "15.0", FormatAmount(150, 1))
+}
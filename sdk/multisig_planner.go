@@ -0,0 +1,223 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"math"
+
+	"github.com/proximax-storage/go-xpx-utils/str"
+)
+
+// MultisigTarget describes desired state of multisig account
+// empty `Cosignatories` with zero thresholds converts multisig account back to the plain one
+type MultisigTarget struct {
+	Cosignatories []*PublicAccount
+	MinApproval   int32
+	MinRemoval    int32
+}
+
+// MultisigModificationPlan contains ready to sign bonded AggregateTransaction, which moves multisig account to the target state
+// `OptInSigners` - added cosignatories, every one of them should cosign aggregate to opt-in
+// `Approvers` - current cosignatories of multisig account, `RequiredApprovals` of them should cosign aggregate,
+// they are empty if account is not multisig yet, so it should sign aggregate by itself
+// `Removal` - plan removes cosignatory, so `RequiredApprovals` is MinRemoval of the account instead of MinApproval
+type MultisigModificationPlan struct {
+	Account           *PublicAccount
+	Transaction       *ModifyMultisigAccountTransaction
+	Aggregate         *AggregateTransaction
+	OptInSigners      []*PublicAccount
+	Approvers         []*PublicAccount
+	RequiredApprovals int32
+	Removal           bool
+}
+
+// returns accounts, which should sign or cosign aggregate in any case
+// approvers of multisig account are not included, because any `RequiredApprovals` of them are enough
+func (p *MultisigModificationPlan) RequiredSigners() []*PublicAccount {
+	signers := make([]*PublicAccount, 0, len(p.OptInSigners)+1)
+
+	if len(p.Approvers) == 0 {
+		signers = append(signers, p.Account)
+	}
+
+	return append(signers, p.OptInSigners...)
+}
+
+// returns true if passed signers are enough to announce aggregate of the plan
+// nested multisig approvers are treated as plain accounts, use MultisigNode to check them
+func (p *MultisigModificationPlan) IsSatisfiedBy(signers ...*PublicAccount) bool {
+	signed := newPublicKeySet(signers...)
+
+	for _, s := range p.RequiredSigners() {
+		if !signed.has(s) {
+			return false
+		}
+	}
+
+	approvals := int32(0)
+	for _, a := range p.Approvers {
+		if signed.has(a) {
+			approvals++
+		}
+	}
+
+	return approvals >= p.RequiredApprovals
+}
+
+func (p *MultisigModificationPlan) String() string {
+	return str.StructToString(
+		"MultisigModificationPlan",
+		str.NewField("Account", str.StringPattern, p.Account),
+		str.NewField("Transaction", str.StringPattern, p.Transaction),
+		str.NewField("OptInSigners", str.StringPattern, p.OptInSigners),
+		str.NewField("Approvers", str.StringPattern, p.Approvers),
+		str.NewField("RequiredApprovals", str.IntPattern, p.RequiredApprovals),
+		str.NewField("Removal", str.BooleanPattern, p.Removal),
+	)
+}
+
+// returns MultisigModificationPlan, which converts passed account to multisig or rotates it's cosignatories and thresholds
+// current should be nil if account is not multisig yet
+// aggregate of the plan is bonded, so it should be announced after confirmed LockFundsTransaction
+func NewMultisigModificationPlan(account *PublicAccount, current *MultisigAccountInfo, target *MultisigTarget, deadline *Deadline, networkType NetworkType) (*MultisigModificationPlan, error) {
+	if account == nil {
+		return nil, ErrNilAccount
+	}
+
+	if target == nil {
+		return nil, ErrNilMultisigTarget
+	}
+
+	if current == nil {
+		current = &MultisigAccountInfo{Account: *account}
+	}
+
+	desired := newPublicKeySet()
+	for _, c := range target.Cosignatories {
+		if c == nil {
+			return nil, ErrNilAccount
+		}
+
+		if desired.has(c) {
+			return nil, ErrDuplicateCosignatory
+		}
+
+		if normalizePublicKey(c.PublicKey) == normalizePublicKey(account.PublicKey) {
+			return nil, ErrAccountCosignsItself
+		}
+
+		desired.add(c)
+	}
+
+	if err := validateMultisigThresholds(target); err != nil {
+		return nil, err
+	}
+
+	existing := newPublicKeySet(current.Cosignatories...)
+
+	modifications := make([]*MultisigCosignatoryModification, 0)
+	added := make([]*PublicAccount, 0)
+
+	for _, c := range target.Cosignatories {
+		if !existing.has(c) {
+			modifications = append(modifications, &MultisigCosignatoryModification{Add, c})
+			added = append(added, c)
+		}
+	}
+
+	removed := 0
+	for _, c := range current.Cosignatories {
+		if !desired.has(c) {
+			modifications = append(modifications, &MultisigCosignatoryModification{Remove, c})
+			removed++
+		}
+	}
+
+	if removed > 1 {
+		return nil, ErrMultipleCosignatoryRemovals
+	}
+
+	minApprovalDelta, err := multisigThresholdDelta(current.MinApproval, target.MinApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	minRemovalDelta, err := multisigThresholdDelta(current.MinRemoval, target.MinRemoval)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modifications) == 0 && minApprovalDelta == 0 && minRemovalDelta == 0 {
+		return nil, ErrEmptyMultisigModification
+	}
+
+	tx, err := NewModifyMultisigAccountTransaction(deadline, minApprovalDelta, minRemovalDelta, modifications, networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.ToAggregate(account)
+
+	aggregate, err := NewBondedAggregateTransaction(deadline, []Transaction{tx}, networkType)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &MultisigModificationPlan{
+		Account:      account,
+		Transaction:  tx,
+		Aggregate:    aggregate,
+		OptInSigners: added,
+		Removal:      removed > 0,
+	}
+
+	if len(current.Cosignatories) > 0 {
+		plan.Approvers = current.Cosignatories
+		plan.RequiredApprovals = current.MinApproval
+
+		if plan.Removal {
+			plan.RequiredApprovals = current.MinRemoval
+		}
+	}
+
+	return plan, nil
+}
+
+func (c *Client) NewMultisigModificationPlan(account *PublicAccount, current *MultisigAccountInfo, target *MultisigTarget, deadline *Deadline) (*MultisigModificationPlan, error) {
+	plan, err := NewMultisigModificationPlan(account, current, target, deadline, c.config.NetworkType)
+	if plan != nil {
+		c.modifyTransaction(plan.Transaction)
+		c.modifyTransaction(plan.Aggregate)
+	}
+
+	return plan, err
+}
+
+func validateMultisigThresholds(target *MultisigTarget) error {
+	count := int32(len(target.Cosignatories))
+
+	if count == 0 {
+		if target.MinApproval != 0 || target.MinRemoval != 0 {
+			return ErrInvalidMultisigThresholds
+		}
+
+		return nil
+	}
+
+	if target.MinApproval < 1 || target.MinApproval > count || target.MinRemoval < 1 || target.MinRemoval > count {
+		return ErrInvalidMultisigThresholds
+	}
+
+	return nil
+}
+
+func multisigThresholdDelta(current, target int32) (int8, error) {
+	delta := int64(target) - int64(current)
+	if delta < math.MinInt8 || delta > math.MaxInt8 {
+		return 0, ErrInvalidMultisigThresholds
+	}
+
+	return int8(delta), nil
+}
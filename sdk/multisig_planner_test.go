@@ -0,0 +1,103 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultisigModificationPlan(t *testing.T) {
+	_, accs := testMultisigGraph(t)
+	deadline := NewDeadline(DefaultRenewalDeadline)
+
+	t.Run("convert to multisig", func(t *testing.T) {
+		plan, err := NewMultisigModificationPlan(accs["M"], nil, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["A"], accs["B"]},
+			MinApproval:   1,
+			MinRemoval:    2,
+		}, deadline, PublicTest)
+		assert.Nil(t, err)
+
+		assert.Equal(t, int8(1), plan.Transaction.MinApprovalDelta)
+		assert.Equal(t, int8(2), plan.Transaction.MinRemovalDelta)
+		assert.Equal(t, []*MultisigCosignatoryModification{{Add, accs["A"]}, {Add, accs["B"]}}, plan.Transaction.Modifications)
+		assert.Equal(t, accs["M"], plan.Transaction.Signer)
+		assert.Equal(t, AggregateBonded, plan.Aggregate.Type)
+		assert.Equal(t, []*PublicAccount{accs["M"], accs["A"], accs["B"]}, plan.RequiredSigners())
+		assert.True(t, plan.IsSatisfiedBy(accs["M"], accs["A"], accs["B"]))
+		assert.False(t, plan.IsSatisfiedBy(accs["M"], accs["A"]))
+	})
+
+	t.Run("rotate cosignatory", func(t *testing.T) {
+		current := &MultisigAccountInfo{
+			Account:       *accs["M"],
+			MinApproval:   1,
+			MinRemoval:    2,
+			Cosignatories: []*PublicAccount{accs["A"], accs["B"]},
+		}
+
+		plan, err := NewMultisigModificationPlan(accs["M"], current, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["A"], accs["C"]},
+			MinApproval:   1,
+			MinRemoval:    2,
+		}, deadline, PublicTest)
+		assert.Nil(t, err)
+
+		assert.Equal(t, int8(0), plan.Transaction.MinApprovalDelta)
+		assert.Equal(t, int8(0), plan.Transaction.MinRemovalDelta)
+		assert.Equal(t, []*MultisigCosignatoryModification{{Add, accs["C"]}, {Remove, accs["B"]}}, plan.Transaction.Modifications)
+		assert.True(t, plan.Removal)
+		assert.Equal(t, int32(2), plan.RequiredApprovals)
+		assert.Equal(t, []*PublicAccount{accs["C"]}, plan.RequiredSigners())
+		assert.False(t, plan.IsSatisfiedBy(accs["A"], accs["C"]))
+		assert.True(t, plan.IsSatisfiedBy(accs["A"], accs["B"], accs["C"]))
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		current := &MultisigAccountInfo{
+			Account:       *accs["M"],
+			MinApproval:   1,
+			MinRemoval:    1,
+			Cosignatories: []*PublicAccount{accs["A"], accs["B"]},
+		}
+
+		_, err := NewMultisigModificationPlan(accs["M"], current, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["C"]},
+			MinApproval:   1,
+			MinRemoval:    1,
+		}, deadline, PublicTest)
+		assert.Equal(t, ErrMultipleCosignatoryRemovals, err)
+
+		_, err = NewMultisigModificationPlan(accs["M"], current, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["A"], accs["B"]},
+			MinApproval:   3,
+			MinRemoval:    1,
+		}, deadline, PublicTest)
+		assert.Equal(t, ErrInvalidMultisigThresholds, err)
+
+		_, err = NewMultisigModificationPlan(accs["M"], current, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["A"], accs["B"]},
+			MinApproval:   1,
+			MinRemoval:    1,
+		}, deadline, PublicTest)
+		assert.Equal(t, ErrEmptyMultisigModification, err)
+
+		_, err = NewMultisigModificationPlan(accs["M"], current, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["A"], accs["A"]},
+			MinApproval:   1,
+			MinRemoval:    1,
+		}, deadline, PublicTest)
+		assert.Equal(t, ErrDuplicateCosignatory, err)
+
+		_, err = NewMultisigModificationPlan(accs["M"], current, &MultisigTarget{
+			Cosignatories: []*PublicAccount{accs["A"], accs["M"]},
+			MinApproval:   1,
+			MinRemoval:    1,
+		}, deadline, PublicTest)
+		assert.Equal(t, ErrAccountCosignsItself, err)
+	})
+}
@@ -0,0 +1,456 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/proximax-storage/go-xpx-utils/str"
+)
+
+// MultisigNode is a node of in-memory multisig accounts tree
+// node without cosignatories is a plain account, which signs transactions by itself
+type MultisigNode struct {
+	Account       *PublicAccount
+	MinApproval   int32
+	MinRemoval    int32
+	Cosignatories []*MultisigNode
+}
+
+// returns MultisigNode for passed public key of account built from passed MultisigAccountGraphInfo
+func NewMultisigTree(graph *MultisigAccountGraphInfo, publicKey string) (*MultisigNode, error) {
+	if graph == nil {
+		return nil, ErrNilMultisigGraph
+	}
+
+	infos := make(map[string]*MultisigAccountInfo)
+	for _, level := range graph.MultisigAccounts {
+		for _, info := range level {
+			infos[normalizePublicKey(info.Account.PublicKey)] = info
+		}
+	}
+
+	info, ok := infos[normalizePublicKey(publicKey)]
+	if !ok {
+		return nil, ErrAccountNotInMultisigGraph
+	}
+
+	return buildMultisigNode(&info.Account, infos, make(map[string]bool)), nil
+}
+
+func buildMultisigNode(account *PublicAccount, infos map[string]*MultisigAccountInfo, visiting map[string]bool) *MultisigNode {
+	key := normalizePublicKey(account.PublicKey)
+	node := &MultisigNode{Account: account}
+
+	info, ok := infos[key]
+	if !ok || len(info.Cosignatories) == 0 || visiting[key] {
+		return node
+	}
+
+	visiting[key] = true
+	defer delete(visiting, key)
+
+	node.MinApproval = info.MinApproval
+	node.MinRemoval = info.MinRemoval
+	node.Cosignatories = make([]*MultisigNode, len(info.Cosignatories))
+
+	for i, cosignatory := range info.Cosignatories {
+		node.Cosignatories[i] = buildMultisigNode(cosignatory, infos, visiting)
+	}
+
+	return node
+}
+
+// returns true if account of node is multisig
+func (n *MultisigNode) IsMultisig() bool {
+	return len(n.Cosignatories) > 0
+}
+
+// returns all not multisig accounts of the tree, which can sign transactions
+func (n *MultisigNode) Leaves() []*PublicAccount {
+	set := make(publicKeySet)
+	n.collectLeaves(set)
+
+	return set.toSortedList()
+}
+
+func (n *MultisigNode) collectLeaves(set publicKeySet) {
+	if !n.IsMultisig() {
+		set.add(n.Account)
+		return
+	}
+
+	for _, c := range n.Cosignatories {
+		c.collectLeaves(set)
+	}
+}
+
+// returns minimal sets of leaf signers, which satisfy MinApproval at every level of the tree
+func (n *MultisigNode) MinimalApprovalSets() [][]*PublicAccount {
+	return keySetsToLists(n.minimalSets(false))
+}
+
+// returns minimal sets of leaf signers, which satisfy MinRemoval of the node and MinApproval of nested multisig accounts
+func (n *MultisigNode) MinimalRemovalSets() [][]*PublicAccount {
+	return keySetsToLists(n.minimalSets(true))
+}
+
+// returns true if passed signers satisfy MinApproval at every level of the tree
+func (n *MultisigNode) CanApprove(signers ...*PublicAccount) bool {
+	return n.satisfied(newPublicKeySet(signers...), false)
+}
+
+// returns true if passed signers satisfy MinRemoval of the node and MinApproval of nested multisig accounts
+func (n *MultisigNode) CanRemove(signers ...*PublicAccount) bool {
+	return n.satisfied(newPublicKeySet(signers...), true)
+}
+
+// returns alternative sets of signers, which are still missing to approve by the node, the smallest sets are the first
+// returns empty list if passed signers already approve
+func (n *MultisigNode) MissingApprovals(signers ...*PublicAccount) [][]*PublicAccount {
+	return missingSigners(n.minimalSets(false), newPublicKeySet(signers...))
+}
+
+// returns alternative sets of signers, which are still missing to remove cosignatories of the node, the smallest sets are the first
+// returns empty list if passed signers already satisfy removal
+func (n *MultisigNode) MissingRemovals(signers ...*PublicAccount) [][]*PublicAccount {
+	return missingSigners(n.minimalSets(true), newPublicKeySet(signers...))
+}
+
+func (n *MultisigNode) String() string {
+	return str.StructToString(
+		"MultisigNode",
+		str.NewField("Account", str.StringPattern, n.Account),
+		str.NewField("MinApproval", str.IntPattern, n.MinApproval),
+		str.NewField("MinRemoval", str.IntPattern, n.MinRemoval),
+		str.NewField("Cosignatories", str.StringPattern, n.Cosignatories),
+	)
+}
+
+func (n *MultisigNode) required(removal bool) int {
+	if removal {
+		return int(n.MinRemoval)
+	}
+
+	return int(n.MinApproval)
+}
+
+func (n *MultisigNode) satisfied(signed publicKeySet, removal bool) bool {
+	if !n.IsMultisig() {
+		return signed.has(n.Account)
+	}
+
+	count := 0
+	for _, c := range n.Cosignatories {
+		if c.satisfied(signed, false) {
+			count++
+		}
+	}
+
+	return count >= n.required(removal)
+}
+
+func (n *MultisigNode) minimalSets(removal bool) []publicKeySet {
+	if !n.IsMultisig() {
+		return []publicKeySet{newPublicKeySet(n.Account)}
+	}
+
+	required := n.required(removal)
+	if required > len(n.Cosignatories) {
+		return nil
+	}
+
+	childSets := make([][]publicKeySet, len(n.Cosignatories))
+	for i, c := range n.Cosignatories {
+		childSets[i] = c.minimalSets(false)
+	}
+
+	result := make([]publicKeySet, 0)
+
+	combinations(len(n.Cosignatories), required, func(chosen []int) {
+		partial := []publicKeySet{make(publicKeySet)}
+
+		for _, i := range chosen {
+			next := make([]publicKeySet, 0, len(partial)*len(childSets[i]))
+
+			for _, p := range partial {
+				for _, s := range childSets[i] {
+					next = append(next, p.union(s))
+				}
+			}
+
+			partial = next
+		}
+
+		result = append(result, partial...)
+	})
+
+	return pruneSupersets(result)
+}
+
+// CosignatureRequirement describes whether signer of inner transaction of aggregate is approved
+// `Missing` contains alternative sets of cosigners, which still should cosign aggregate, the smallest sets are the first
+type CosignatureRequirement struct {
+	Signer    *PublicAccount
+	Satisfied bool
+	Missing   [][]*PublicAccount
+}
+
+func (r *CosignatureRequirement) String() string {
+	return str.StructToString(
+		"CosignatureRequirement",
+		str.NewField("Signer", str.StringPattern, r.Signer),
+		str.NewField("Satisfied", str.BooleanPattern, r.Satisfied),
+		str.NewField("Missing", str.StringPattern, r.Missing),
+	)
+}
+
+// returns CosignatureRequirement's for every signer of inner transactions of passed AggregateTransaction
+// signer and cosignatures of aggregate are treated as already signed
+// passed trees are used for multisig signers of inner transactions, other signers should sign by themselves
+func AggregateCosignatureRequirements(tx *AggregateTransaction, trees ...*MultisigNode) []*CosignatureRequirement {
+	signers := make([]*PublicAccount, 0, len(tx.Cosignatures)+1)
+
+	if tx.Signer != nil {
+		signers = append(signers, tx.Signer)
+	}
+
+	for _, c := range tx.Cosignatures {
+		signers = append(signers, c.Signer)
+	}
+
+	return aggregateRequirements(tx, newPublicKeySet(signers...), trees)
+}
+
+// returns true if passed signers are enough to approve every inner transaction of passed AggregateTransaction
+func CanApproveAggregate(tx *AggregateTransaction, signers []*PublicAccount, trees ...*MultisigNode) bool {
+	for _, r := range aggregateRequirements(tx, newPublicKeySet(signers...), trees) {
+		if !r.Satisfied {
+			return false
+		}
+	}
+
+	return true
+}
+
+func aggregateRequirements(tx *AggregateTransaction, signed publicKeySet, trees []*MultisigNode) []*CosignatureRequirement {
+	nodes := make(map[string]*MultisigNode, len(trees))
+	for _, t := range trees {
+		nodes[normalizePublicKey(t.Account.PublicKey)] = t
+	}
+
+	// the same account can sign several inner transactions, removal of cosignatories is the strongest requirement
+	signers := make([]*PublicAccount, 0, len(tx.InnerTransactions))
+	removals := make(map[string]bool)
+
+	for _, inner := range tx.InnerTransactions {
+		signer := inner.GetAbstractTransaction().Signer
+		if signer == nil {
+			continue
+		}
+
+		key := normalizePublicKey(signer.PublicKey)
+		if _, ok := removals[key]; !ok {
+			signers = append(signers, signer)
+		}
+
+		removals[key] = removals[key] || isCosignatoryRemoval(inner)
+	}
+
+	requirements := make([]*CosignatureRequirement, len(signers))
+
+	for i, signer := range signers {
+		key := normalizePublicKey(signer.PublicKey)
+
+		node, ok := nodes[key]
+		if !ok {
+			node = &MultisigNode{Account: signer}
+		}
+
+		removal := removals[key]
+
+		requirements[i] = &CosignatureRequirement{
+			Signer:    signer,
+			Satisfied: node.satisfied(signed, removal),
+			Missing:   missingSigners(node.minimalSets(removal), signed),
+		}
+	}
+
+	return requirements
+}
+
+func isCosignatoryRemoval(tx Transaction) bool {
+	mtx, ok := tx.(*ModifyMultisigAccountTransaction)
+	if !ok {
+		return false
+	}
+
+	for _, m := range mtx.Modifications {
+		if m.Type == Remove {
+			return true
+		}
+	}
+
+	return false
+}
+
+type publicKeySet map[string]*PublicAccount
+
+func newPublicKeySet(accounts ...*PublicAccount) publicKeySet {
+	set := make(publicKeySet, len(accounts))
+	for _, a := range accounts {
+		if a != nil {
+			set.add(a)
+		}
+	}
+
+	return set
+}
+
+func (s publicKeySet) add(account *PublicAccount) {
+	s[normalizePublicKey(account.PublicKey)] = account
+}
+
+func (s publicKeySet) has(account *PublicAccount) bool {
+	_, ok := s[normalizePublicKey(account.PublicKey)]
+	return ok
+}
+
+func (s publicKeySet) union(other publicKeySet) publicKeySet {
+	res := make(publicKeySet, len(s)+len(other))
+	for k, v := range s {
+		res[k] = v
+	}
+
+	for k, v := range other {
+		res[k] = v
+	}
+
+	return res
+}
+
+func (s publicKeySet) difference(other publicKeySet) publicKeySet {
+	res := make(publicKeySet)
+	for k, v := range s {
+		if _, ok := other[k]; !ok {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
+func (s publicKeySet) isSubsetOf(other publicKeySet) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for k := range s {
+		if _, ok := other[k]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s publicKeySet) keys() []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func (s publicKeySet) toSortedList() []*PublicAccount {
+	list := make([]*PublicAccount, 0, len(s))
+	for _, k := range s.keys() {
+		list = append(list, s[k])
+	}
+
+	return list
+}
+
+// removes duplicates and sets, which contain another set, sorts sets by size and keys
+func pruneSupersets(sets []publicKeySet) []publicKeySet {
+	sort.SliceStable(sets, func(i, j int) bool {
+		if len(sets[i]) != len(sets[j]) {
+			return len(sets[i]) < len(sets[j])
+		}
+
+		return strings.Join(sets[i].keys(), ",") < strings.Join(sets[j].keys(), ",")
+	})
+
+	result := make([]publicKeySet, 0, len(sets))
+
+SetsLoop:
+	for _, s := range sets {
+		for _, r := range result {
+			if r.isSubsetOf(s) {
+				continue SetsLoop
+			}
+		}
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func missingSigners(sets []publicKeySet, signed publicKeySet) [][]*PublicAccount {
+	missing := make([]publicKeySet, 0, len(sets))
+
+	for _, s := range sets {
+		diff := s.difference(signed)
+		if len(diff) == 0 {
+			return [][]*PublicAccount{}
+		}
+
+		missing = append(missing, diff)
+	}
+
+	return keySetsToLists(pruneSupersets(missing))
+}
+
+func keySetsToLists(sets []publicKeySet) [][]*PublicAccount {
+	lists := make([][]*PublicAccount, len(sets))
+	for i, s := range sets {
+		lists[i] = s.toSortedList()
+	}
+
+	return lists
+}
+
+// calls passed function for every combination of k indexes from [0, n)
+func combinations(n int, k int, fn func([]int)) {
+	if k <= 0 {
+		fn([]int{})
+		return
+	}
+
+	chosen := make([]int, k)
+
+	var rec func(start, depth int)
+	rec = func(start, depth int) {
+		if depth == k {
+			fn(chosen)
+			return
+		}
+
+		for i := start; i <= n-(k-depth); i++ {
+			chosen[depth] = i
+			rec(i+1, depth+1)
+		}
+	}
+
+	rec(0, 0)
+}
+
+func normalizePublicKey(publicKey string) string {
+	return strings.ToUpper(publicKey)
+}
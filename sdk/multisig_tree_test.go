@@ -0,0 +1,130 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testMultisigPublicAccount(t *testing.T, b byte) *PublicAccount {
+	acc, err := NewAccountFromPublicKey(strings.Repeat(string("0123456789ABCDEF"[b]), 64), PublicTest)
+	assert.Nil(t, err)
+
+	return acc
+}
+
+// multisig M requires 2 of [A, B, N] to approve and all of them to remove, multisig N requires 1 of [C, D]
+func testMultisigGraph(t *testing.T) (*MultisigAccountGraphInfo, map[string]*PublicAccount) {
+	accs := map[string]*PublicAccount{
+		"M": testMultisigPublicAccount(t, 1),
+		"N": testMultisigPublicAccount(t, 2),
+		"A": testMultisigPublicAccount(t, 3),
+		"B": testMultisigPublicAccount(t, 4),
+		"C": testMultisigPublicAccount(t, 5),
+		"D": testMultisigPublicAccount(t, 6),
+	}
+
+	return &MultisigAccountGraphInfo{
+		MultisigAccounts: map[int32][]*MultisigAccountInfo{
+			0: {{
+				Account:       *accs["M"],
+				MinApproval:   2,
+				MinRemoval:    3,
+				Cosignatories: []*PublicAccount{accs["A"], accs["B"], accs["N"]},
+			}},
+			1: {{
+				Account:          *accs["N"],
+				MinApproval:      1,
+				MinRemoval:       1,
+				Cosignatories:    []*PublicAccount{accs["C"], accs["D"]},
+				MultisigAccounts: []*PublicAccount{accs["M"]},
+			}},
+		},
+	}, accs
+}
+
+func TestMultisigNode(t *testing.T) {
+	graph, accs := testMultisigGraph(t)
+
+	tree, err := NewMultisigTree(graph, accs["M"].PublicKey)
+	assert.Nil(t, err)
+	assert.True(t, tree.IsMultisig())
+	assert.Equal(t, []*PublicAccount{accs["A"], accs["B"], accs["C"], accs["D"]}, tree.Leaves())
+
+	assert.Equal(t, [][]*PublicAccount{
+		{accs["A"], accs["B"]},
+		{accs["A"], accs["C"]},
+		{accs["A"], accs["D"]},
+		{accs["B"], accs["C"]},
+		{accs["B"], accs["D"]},
+	}, tree.MinimalApprovalSets())
+
+	assert.Equal(t, [][]*PublicAccount{
+		{accs["A"], accs["B"], accs["C"]},
+		{accs["A"], accs["B"], accs["D"]},
+	}, tree.MinimalRemovalSets())
+
+	assert.True(t, tree.CanApprove(accs["A"], accs["D"]))
+	assert.False(t, tree.CanApprove(accs["C"], accs["D"]))
+	assert.False(t, tree.CanRemove(accs["A"], accs["B"]))
+	assert.True(t, tree.CanRemove(accs["A"], accs["B"], accs["C"]))
+
+	assert.Equal(t, [][]*PublicAccount{{accs["A"]}, {accs["B"]}}, tree.MissingApprovals(accs["C"]))
+	assert.Equal(t, [][]*PublicAccount{}, tree.MissingApprovals(accs["A"], accs["B"]))
+	assert.Equal(t, [][]*PublicAccount{{accs["C"]}, {accs["D"]}}, tree.MissingRemovals(accs["A"], accs["B"]))
+
+	_, err = NewMultisigTree(graph, accs["A"].PublicKey)
+	assert.Equal(t, ErrAccountNotInMultisigGraph, err)
+
+	_, err = NewMultisigTree(nil, accs["M"].PublicKey)
+	assert.Equal(t, ErrNilMultisigGraph, err)
+}
+
+func TestAggregateCosignatureRequirements(t *testing.T) {
+	graph, accs := testMultisigGraph(t)
+
+	tree, err := NewMultisigTree(graph, accs["M"].PublicKey)
+	assert.Nil(t, err)
+
+	transfer, err := NewTransferTransaction(NewDeadline(DefaultRenewalDeadline), accs["A"].Address, []*Mosaic{}, NewPlainMessage(""), PublicTest)
+	assert.Nil(t, err)
+	transfer.ToAggregate(accs["M"])
+
+	modify, err := NewModifyMultisigAccountTransaction(
+		NewDeadline(DefaultRenewalDeadline),
+		0,
+		0,
+		[]*MultisigCosignatoryModification{{Remove, accs["B"]}},
+		PublicTest,
+	)
+	assert.Nil(t, err)
+	modify.ToAggregate(accs["M"])
+
+	aggregate, err := NewBondedAggregateTransaction(NewDeadline(DefaultRenewalDeadline), []Transaction{transfer}, PublicTest)
+	assert.Nil(t, err)
+	aggregate.Signer = accs["C"]
+
+	requirements := AggregateCosignatureRequirements(aggregate, tree)
+	assert.Len(t, requirements, 1)
+	assert.False(t, requirements[0].Satisfied)
+	assert.Equal(t, [][]*PublicAccount{{accs["A"]}, {accs["B"]}}, requirements[0].Missing)
+
+	aggregate.Cosignatures = []*AggregateTransactionCosignature{{Signer: accs["B"]}}
+	requirements = AggregateCosignatureRequirements(aggregate, tree)
+	assert.True(t, requirements[0].Satisfied)
+	assert.Len(t, requirements[0].Missing, 0)
+
+	aggregate.InnerTransactions = append(aggregate.InnerTransactions, modify)
+	requirements = AggregateCosignatureRequirements(aggregate, tree)
+	assert.Len(t, requirements, 1)
+	assert.False(t, requirements[0].Satisfied)
+	assert.Equal(t, [][]*PublicAccount{{accs["A"]}}, requirements[0].Missing)
+
+	assert.True(t, CanApproveAggregate(aggregate, []*PublicAccount{accs["A"], accs["B"], accs["D"]}, tree))
+	assert.False(t, CanApproveAggregate(aggregate, []*PublicAccount{accs["A"], accs["B"]}, tree))
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/proximax-storage/go-xpx-utils/net"
 )
 
+// number of namespaces in one page, when all namespaces of account are requested
+const namespacesPageSize = 100
+
 // NamespaceService provides a set of methods for obtaining information about the namespace
 type NamespaceService service
 
@@ -87,6 +90,33 @@ func (ref *NamespaceService) GetNamespaceInfosFromAccount(ctx context.Context, a
 	return nsInfos, nil
 }
 
+// returns all NamespaceInfo's of passed Address requesting them page by page
+// every next page starts after the last namespace of previous one, the short page is the last one
+func (ref *NamespaceService) getAllNamespaceInfosFromAccount(ctx context.Context, address *Address) ([]*NamespaceInfo, error) {
+	nsInfos := make([]*NamespaceInfo, 0)
+	var cursor *NamespaceId
+
+	for {
+		page, err := ref.GetNamespaceInfosFromAccount(ctx, address, cursor, namespacesPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		nsInfos = append(nsInfos, page...)
+
+		if len(page) < namespacesPageSize {
+			return nsInfos, nil
+		}
+
+		last := page[len(page)-1].NamespaceId
+		if cursor != nil && last.Equals(cursor) {
+			return nil, ErrNamespacePageStalled
+		}
+
+		cursor = last
+	}
+}
+
 // returns NamespaceInfo's corresponding to passed Address's and NamespaceId with maximum limit
 // TODO: fix pagination
 func (ref *NamespaceService) GetNamespaceInfosFromAccounts(ctx context.Context, addrs []*Address, nsId *NamespaceId,
@@ -179,6 +209,95 @@ func (ref *NamespaceService) GetLinkedAddress(ctx context.Context, namespaceId *
 	return info.Alias.Address(), nil
 }
 
+// returns NamespaceInfo for passed namespace name in format like 'rootname.childname.grandchildname'
+func (ref *NamespaceService) GetNamespaceInfoByName(ctx context.Context, name string) (*NamespaceInfo, error) {
+	nsId, err := NewNamespaceIdFromName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.GetNamespaceInfo(ctx, nsId)
+}
+
+// returns NamespaceInfo's of every level of passed namespace name starting from root
+// Example: GetNamespaceInfosByPath(ctx, "foo.bar.baz") => [NamespaceInfo(foo), NamespaceInfo(foo.bar), NamespaceInfo(foo.bar.baz)]
+func (ref *NamespaceService) GetNamespaceInfosByPath(ctx context.Context, name string) ([]*NamespaceInfo, error) {
+	path, err := GenerateNamespacePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	nsInfo, err := ref.GetNamespaceInfo(ctx, path[len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	nsInfos := make([]*NamespaceInfo, len(path))
+	for i := len(path) - 1; i >= 0; i-- {
+		if nsInfo == nil {
+			return nil, ErrResourceNotFound
+		}
+
+		nsInfos[i] = nsInfo
+		nsInfo = nsInfo.Parent
+	}
+
+	return nsInfos, nil
+}
+
+// returns NamespaceNode of root namespace for passed NamespaceId with all subnamespaces of the root
+// subnamespaces are requested from namespaces of root owner, because only owner of root namespace can register subnamespaces
+func (ref *NamespaceService) GetNamespaceTree(ctx context.Context, nsId *NamespaceId) (*NamespaceNode, error) {
+	nsInfo, err := ref.GetNamespaceInfo(ctx, nsId)
+	if err != nil {
+		return nil, err
+	}
+
+	root := nsInfo
+	for root.Parent != nil {
+		root = root.Parent
+	}
+
+	ownerAddress, err := NewAddressFromPublicKey(root.Owner.PublicKey, ref.client.config.NetworkType)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerNsInfos, err := ref.getAllNamespaceInfosFromAccount(ctx, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	nsInfos := []*NamespaceInfo{root}
+	for _, info := range ownerNsInfos {
+		if info.Depth > 1 && len(info.Levels) > 0 && info.Levels[0].Equals(root.NamespaceId) {
+			nsInfos = append(nsInfos, info)
+		}
+	}
+
+	nsIds := make([]*NamespaceId, len(nsInfos))
+	for i, info := range nsInfos {
+		nsIds[i] = info.NamespaceId
+	}
+
+	nsNames, err := ref.GetNamespaceNames(ctx, nsIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildNamespaceTree(nsInfos, nsNames), nil
+}
+
+// returns NamespaceNode of root namespace for passed namespace name with all subnamespaces of the root
+func (ref *NamespaceService) GetNamespaceTreeByName(ctx context.Context, name string) (*NamespaceNode, error) {
+	nsId, err := NewNamespaceIdFromName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.GetNamespaceTree(ctx, nsId)
+}
+
 func (ref *NamespaceService) buildNamespaceHierarchy(ctx context.Context, nsInfo *NamespaceInfo) error {
 	if nsInfo == nil || nsInfo.Parent == nil {
 		return nil
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"encoding/binary"
+	"sort"
 
 	"golang.org/x/crypto/sha3"
 )
@@ -211,6 +212,49 @@ func (n *namespaceInfoDTOs) toStruct() ([]*NamespaceInfo, error) {
 	return nsInfos, nil
 }
 
+// returns root NamespaceNode built from passed NamespaceInfo's, where the first one is the root
+func buildNamespaceTree(nsInfos []*NamespaceInfo, nsNames []*NamespaceName) *NamespaceNode {
+	names := make(map[uint64]string, len(nsNames))
+	for _, name := range nsNames {
+		names[name.NamespaceId.Id()] = name.FullName
+	}
+
+	nodes := make(map[uint64]*NamespaceNode, len(nsInfos))
+	for _, info := range nsInfos {
+		nodes[info.NamespaceId.Id()] = &NamespaceNode{
+			NamespaceInfo: info,
+			Name:          names[info.NamespaceId.Id()],
+			Children:      make([]*NamespaceNode, 0),
+		}
+	}
+
+	// linking from the lowest depth to keep children ordered by depth and name
+	sorted := make([]*NamespaceInfo, len(nsInfos)-1)
+	copy(sorted, nsInfos[1:])
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Depth != sorted[j].Depth {
+			return sorted[i].Depth < sorted[j].Depth
+		}
+
+		return names[sorted[i].NamespaceId.Id()] < names[sorted[j].NamespaceId.Id()]
+	})
+
+	for _, info := range sorted {
+		if len(info.Levels) < 2 {
+			continue
+		}
+
+		parent, ok := nodes[info.Levels[len(info.Levels)-2].Id()]
+		if !ok {
+			continue
+		}
+
+		parent.Children = append(parent.Children, nodes[info.NamespaceId.Id()])
+	}
+
+	return nodes[nsInfos[0].NamespaceId.Id()]
+}
+
 func generateNamespaceId(name string, parentId *NamespaceId) (*NamespaceId, error) {
 	b := parentId.toLittleEndian()
 
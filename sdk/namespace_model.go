@@ -209,6 +209,57 @@ func (n *NamespaceName) String() string {
 	)
 }
 
+// NamespaceNode is a node of namespace hierarchy tree with full name of namespace and it's subnamespaces
+type NamespaceNode struct {
+	*NamespaceInfo
+	Name     string
+	Children []*NamespaceNode
+}
+
+// calls passed function for current node and every node below it in depth-first order
+// walking is stopped if function returns false
+func (ref *NamespaceNode) Walk(fn func(node *NamespaceNode) bool) bool {
+	if !fn(ref) {
+		return false
+	}
+
+	for _, child := range ref.Children {
+		if !child.Walk(fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// returns NamespaceNode with passed full name like 'rootname.childname' or nil if tree doesn't contain it
+func (ref *NamespaceNode) Find(name string) *NamespaceNode {
+	var found *NamespaceNode
+
+	ref.Walk(func(node *NamespaceNode) bool {
+		if node.Name == name {
+			found = node
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func (ref *NamespaceNode) String() string {
+	return str.StructToString(
+		"NamespaceNode",
+		str.NewField("Name", str.StringPattern, ref.Name),
+		str.NewField("NamespaceId", str.StringPattern, ref.NamespaceId),
+		str.NewField("Alias", str.StringPattern, ref.Alias),
+		str.NewField("Owner", str.StringPattern, ref.Owner),
+		str.NewField("EndHeight", str.StringPattern, ref.EndHeight),
+		str.NewField("Children", str.StringPattern, ref.Children),
+	)
+}
+
 // returns an array of big ints representation if namespace ids from passed namespace path
 // to create root namespace pass namespace name in format like 'rootname'
 // to create child namespace pass namespace name in format like 'rootname.childname'
@@ -6,6 +6,10 @@ package sdk
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/json-iterator/go"
@@ -194,3 +198,163 @@ func TestNamespaceService_GetNamespaceNames(t *testing.T) {
 		assert.Equal(t, ErrEmptyNamespaceIds, err, "request with empty NamespaceIds must return error")
 	})
 }
+
+func testNamespaceInfoJson(name string, aliasJson string) string {
+	path, err := GenerateNamespacePath(name)
+	if err != nil {
+		panic(err)
+	}
+
+	idJson := func(id *NamespaceId) string {
+		arr := id.toArray()
+		return fmt.Sprintf("[%d, %d]", arr[0], arr[1])
+	}
+
+	levels := ""
+	for i, level := range path {
+		levels += fmt.Sprintf(`"level%d": %s,`, i, idJson(level))
+	}
+
+	parentId := "[0, 0]"
+	if len(path) > 1 {
+		parentId = idJson(path[len(path)-2])
+	}
+
+	return fmt.Sprintf(`{
+		"meta": {"active": true, "index": 0, "id": "5B55E02EACCB7B00015DB6EB"},
+		"namespace": {
+			"type": %d,
+			"depth": %d,
+			%s
+			"parentId": %s,
+			"alias": %s,
+			"owner": "321DE652C4D3362FC2DDF7800F6582F4A10CFEA134B81F8AB6E4BE78BBA4D18E",
+			"ownerAddress": "904A1B7A7432C968202264C2CBDE0E8E5547EED3AD66E52BAC",
+			"startHeight": [1, 0],
+			"endHeight": [1000, 0]
+		}
+	}`, len(path)-1, len(path), levels, parentId, aliasJson)
+}
+
+func TestNamespaceService_getAllNamespaceInfosFromAccount(t *testing.T) {
+	ids := make([]string, 0, 150)
+	infosJson := make([]string, 0, 150)
+
+	for i := 0; i < 150; i++ {
+		name := fmt.Sprintf("ns%d", i)
+		nsId, err := NewNamespaceIdFromName(name)
+		assert.Nil(t, err)
+
+		ids = append(ids, nsId.toHexString())
+		infosJson = append(infosJson, testNamespaceInfoJson(name, `{"type": 0}`))
+	}
+
+	stalled := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		start := 0
+		if id := r.URL.Query().Get("id"); id != "" && !stalled {
+			for i := range ids {
+				if ids[i] == id {
+					start = i + 1
+				}
+			}
+		}
+
+		end := start + pageSize
+		if end > len(infosJson) {
+			end = len(infosJson)
+		}
+
+		_, _ = fmt.Fprint(w, "["+strings.Join(infosJson[start:end], ",")+"]")
+	}))
+	defer server.Close()
+
+	conf, err := NewConfigWithReputation([]string{server.URL}, PublicTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, nil, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	client := NewClient(nil, conf)
+
+	nsInfos, err := client.Namespace.getAllNamespaceInfosFromAccount(ctx, &Address{PublicTest, nemTestAddress1})
+	assert.Nil(t, err)
+	assert.Len(t, nsInfos, 150)
+	assert.Equal(t, ids[149], nsInfos[149].NamespaceId.toHexString())
+
+	stalled = true
+
+	_, err = client.Namespace.getAllNamespaceInfosFromAccount(ctx, &Address{PublicTest, nemTestAddress1})
+	assert.Equal(t, ErrNamespacePageStalled, err)
+}
+
+func TestNamespaceService_GetNamespaceTree(t *testing.T) {
+	noAlias := `{"type": 0}`
+	names := []string{"foo", "foo.bar", "foo.bar.baz", "foo.abc"}
+
+	infosJson := make([]string, len(names))
+	namesJson := make([]string, len(names))
+
+	mockServ := newSdkMock(0)
+	defer mockServ.Close()
+
+	for i, name := range names {
+		nsId, err := NewNamespaceIdFromName(name)
+		assert.Nil(t, err)
+
+		alias := noAlias
+		if name == "foo.bar" {
+			alias = `{"type": 1, "mosaicId": [1382215848, 1583663204]}`
+		}
+
+		infosJson[i] = testNamespaceInfoJson(name, alias)
+		arr := nsId.toArray()
+		namesJson[i] = fmt.Sprintf(`{"namespaceId": [%d, %d], "name": "%s"}`, arr[0], arr[1], name)
+
+		mockServ.AddRouter(&mock.Router{
+			Path:     fmt.Sprintf(namespaceRoute, nsId.toHexString()),
+			RespBody: infosJson[i],
+		})
+	}
+
+	client := mockServ.getPublicTestClientUnsafe()
+
+	owner, err := NewAddressFromPublicKey("321DE652C4D3362FC2DDF7800F6582F4A10CFEA134B81F8AB6E4BE78BBA4D18E", client.NetworkType())
+	assert.Nil(t, err)
+
+	mockServ.AddRouter(&mock.Router{
+		Path:     fmt.Sprintf(namespacesFromAccountRoutes, owner.Address),
+		RespBody: "[" + strings.Join(infosJson, ",") + "]",
+	})
+	mockServ.AddRouter(&mock.Router{
+		Path:     namespaceNamesRoute,
+		RespBody: "[" + strings.Join(namesJson, ",") + "]",
+	})
+
+	t.Run("GetNamespaceInfosByPath", func(t *testing.T) {
+		nsInfos, err := client.Namespace.GetNamespaceInfosByPath(ctx, "foo.bar.baz")
+		assert.Nilf(t, err, "NamespaceService.GetNamespaceInfosByPath returned error: %s", err)
+		assert.Len(t, nsInfos, 3)
+
+		for i, name := range []string{"foo", "foo.bar", "foo.bar.baz"} {
+			nsId, _ := NewNamespaceIdFromName(name)
+			assert.Equal(t, nsId.Id(), nsInfos[i].NamespaceId.Id())
+		}
+
+		assert.Equal(t, MosaicAliasType, nsInfos[1].Alias.Type)
+	})
+
+	t.Run("GetNamespaceTreeByName", func(t *testing.T) {
+		tree, err := client.Namespace.GetNamespaceTreeByName(ctx, "foo.bar.baz")
+		assert.Nilf(t, err, "NamespaceService.GetNamespaceTreeByName returned error: %s", err)
+
+		assert.Equal(t, "foo", tree.Name)
+		assert.Len(t, tree.Children, 2)
+		assert.Equal(t, "foo.abc", tree.Children[0].Name)
+		assert.Equal(t, "foo.bar", tree.Children[1].Name)
+		assert.Len(t, tree.Children[1].Children, 1)
+		assert.Equal(t, Height(1000), tree.Find("foo.bar.baz").EndHeight)
+		assert.Equal(t, MosaicAliasType, tree.Find("foo.bar").Alias.Type)
+		assert.Nil(t, tree.Find("foo.unknown"))
+	})
+}
@@ -0,0 +1,594 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// names of well-known sections of NetworkConfig, which have typed views
+const (
+	ChainConfigSection      = "chain"
+	TransferPluginSection   = "plugin:catapult.plugins.transfer"
+	NamespacePluginSection  = "plugin:catapult.plugins.namespace"
+	MosaicPluginSection     = "plugin:catapult.plugins.mosaic"
+	MultisigPluginSection   = "plugin:catapult.plugins.multisig"
+	AggregatePluginSection  = "plugin:catapult.plugins.aggregate"
+	HashLockPluginSection   = "plugin:catapult.plugins.lockhash"
+	SecretLockPluginSection = "plugin:catapult.plugins.locksecret"
+	ContractPluginSection   = "plugin:catapult.plugins.contract"
+	MetadataPluginSection   = "plugin:catapult.plugins.metadata"
+)
+
+// ChainConfig is a typed view of chain section of NetworkConfig
+type ChainConfig struct {
+	CurrencyMosaicId             *MosaicId
+	HarvestingMosaicId           *MosaicId
+	BlockGenerationTargetTime    time.Duration
+	BlockTimeSmoothingFactor     uint32
+	ImportanceGrouping           uint64
+	MaxRollbackBlocks            uint32
+	MaxDifficultyBlocks          uint32
+	MaxTransactionLifetime       time.Duration
+	MaxBlockFutureTime           time.Duration
+	MaxMosaicAtomicUnits         Amount
+	TotalChainImportance         Amount
+	MinHarvesterBalance          Amount
+	HarvestBeneficiaryPercentage uint8
+	BlockPruneInterval           uint32
+	MaxTransactionsPerBlock      uint32
+}
+
+// TransferConfig is a typed view of transfer plugin section of NetworkConfig
+type TransferConfig struct {
+	MaxMessageSize uint32
+}
+
+// NamespaceConfig is a typed view of namespace plugin section of NetworkConfig
+type NamespaceConfig struct {
+	MaxNameSize                     uint8
+	MaxNamespaceDuration            time.Duration
+	NamespaceGracePeriodDuration    time.Duration
+	ReservedRootNamespaceNames      []string
+	NamespaceRentalFeeSinkPublicKey string
+	RootNamespaceRentalFeePerBlock  Amount
+	ChildNamespaceRentalFee         Amount
+	MaxChildNamespaces              uint32
+}
+
+// MosaicConfig is a typed view of mosaic plugin section of NetworkConfig
+type MosaicConfig struct {
+	MaxMosaicsPerAccount         uint32
+	MaxMosaicDuration            time.Duration
+	MaxMosaicDivisibility        uint8
+	MosaicRentalFeeSinkPublicKey string
+	MosaicRentalFee              Amount
+}
+
+// MultisigConfig is a typed view of multisig plugin section of NetworkConfig
+type MultisigConfig struct {
+	MaxMultisigDepth              uint8
+	MaxCosignersPerAccount        uint8
+	MaxCosignedAccountsPerAccount uint8
+}
+
+// AggregateConfig is a typed view of aggregate plugin section of NetworkConfig
+type AggregateConfig struct {
+	MaxTransactionsPerAggregate  uint32
+	MaxCosignaturesPerAggregate  uint8
+	EnableStrictCosignatureCheck bool
+	EnableBondedAggregateSupport bool
+	MaxBondedTransactionLifetime time.Duration
+}
+
+// HashLockConfig is a typed view of hash lock plugin section of NetworkConfig
+type HashLockConfig struct {
+	LockedFundsPerAggregate Amount
+	MaxHashLockDuration     time.Duration
+}
+
+// SecretLockConfig is a typed view of secret lock plugin section of NetworkConfig
+type SecretLockConfig struct {
+	MaxSecretLockDuration time.Duration
+	MinProofSize          uint16
+	MaxProofSize          uint16
+}
+
+// ContractConfig is a typed view of contract plugin section of NetworkConfig, percentages are in range [0, 100]
+type ContractConfig struct {
+	MinPercentageOfApproval uint8
+	MinPercentageOfRemoval  uint8
+}
+
+// MetadataConfig is a typed view of metadata plugin section of NetworkConfig
+type MetadataConfig struct {
+	MaxFields         uint8
+	MaxFieldKeySize   uint8
+	MaxFieldValueSize uint16
+}
+
+// returns typed view of chain section, it fails if some required field is missing or invalid
+// fields, which are missing in configs of older versions of blockchain, are left zero
+func (c *NetworkConfig) ChainConfig() (*ChainConfig, error) {
+	r, err := c.sectionReader(ChainConfigSection, "harvestBeneficiaryPercentage", "blockPruneInterval", "maxTransactionsPerBlock")
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &ChainConfig{
+		CurrencyMosaicId:             r.mosaicId("currencyMosaicId"),
+		HarvestingMosaicId:           r.mosaicId("harvestingMosaicId"),
+		BlockGenerationTargetTime:    r.duration("blockGenerationTargetTime"),
+		BlockTimeSmoothingFactor:     uint32(r.uint("blockTimeSmoothingFactor", 32)),
+		ImportanceGrouping:           r.uint("importanceGrouping", 64),
+		MaxRollbackBlocks:            uint32(r.uint("maxRollbackBlocks", 32)),
+		MaxDifficultyBlocks:          uint32(r.uint("maxDifficultyBlocks", 32)),
+		MaxTransactionLifetime:       r.duration("maxTransactionLifetime"),
+		MaxBlockFutureTime:           r.duration("maxBlockFutureTime"),
+		MaxMosaicAtomicUnits:         r.amount("maxMosaicAtomicUnits"),
+		TotalChainImportance:         r.amount("totalChainImportance"),
+		MinHarvesterBalance:          r.amount("minHarvesterBalance"),
+		HarvestBeneficiaryPercentage: uint8(r.uint("harvestBeneficiaryPercentage", 8)),
+		BlockPruneInterval:           uint32(r.uint("blockPruneInterval", 32)),
+		MaxTransactionsPerBlock:      uint32(r.uint("maxTransactionsPerBlock", 32)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of transfer plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) TransferConfig() (*TransferConfig, error) {
+	r, err := c.sectionReader(TransferPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &TransferConfig{
+		MaxMessageSize: uint32(r.uint("maxMessageSize", 32)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of namespace plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) NamespaceConfig() (*NamespaceConfig, error) {
+	r, err := c.sectionReader(NamespacePluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &NamespaceConfig{
+		MaxNameSize:                     uint8(r.uint("maxNameSize", 8)),
+		MaxNamespaceDuration:            r.duration("maxNamespaceDuration"),
+		NamespaceGracePeriodDuration:    r.duration("namespaceGracePeriodDuration"),
+		ReservedRootNamespaceNames:      r.list("reservedRootNamespaceNames"),
+		NamespaceRentalFeeSinkPublicKey: r.publicKey("namespaceRentalFeeSinkPublicKey"),
+		RootNamespaceRentalFeePerBlock:  r.amount("rootNamespaceRentalFeePerBlock"),
+		ChildNamespaceRentalFee:         r.amount("childNamespaceRentalFee"),
+		MaxChildNamespaces:              uint32(r.uint("maxChildNamespaces", 32)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of mosaic plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) MosaicConfig() (*MosaicConfig, error) {
+	r, err := c.sectionReader(MosaicPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &MosaicConfig{
+		MaxMosaicsPerAccount:         uint32(r.uint("maxMosaicsPerAccount", 32)),
+		MaxMosaicDuration:            r.duration("maxMosaicDuration"),
+		MaxMosaicDivisibility:        uint8(r.uint("maxMosaicDivisibility", 8)),
+		MosaicRentalFeeSinkPublicKey: r.publicKey("mosaicRentalFeeSinkPublicKey"),
+		MosaicRentalFee:              r.amount("mosaicRentalFee"),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of multisig plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) MultisigConfig() (*MultisigConfig, error) {
+	r, err := c.sectionReader(MultisigPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &MultisigConfig{
+		MaxMultisigDepth:              uint8(r.uint("maxMultisigDepth", 8)),
+		MaxCosignersPerAccount:        uint8(r.uint("maxCosignersPerAccount", 8)),
+		MaxCosignedAccountsPerAccount: uint8(r.uint("maxCosignedAccountsPerAccount", 8)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of aggregate plugin section, it fails if some required field is missing or invalid
+// bonded aggregate fields, which are missing in configs of older versions of blockchain, are left zero
+func (c *NetworkConfig) AggregateConfig() (*AggregateConfig, error) {
+	r, err := c.sectionReader(AggregatePluginSection, "enableBondedAggregateSupport", "maxBondedTransactionLifetime")
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &AggregateConfig{
+		MaxTransactionsPerAggregate:  uint32(r.uint("maxTransactionsPerAggregate", 32)),
+		MaxCosignaturesPerAggregate:  uint8(r.uint("maxCosignaturesPerAggregate", 8)),
+		EnableStrictCosignatureCheck: r.bool("enableStrictCosignatureCheck"),
+		EnableBondedAggregateSupport: r.bool("enableBondedAggregateSupport"),
+		MaxBondedTransactionLifetime: r.duration("maxBondedTransactionLifetime"),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of hash lock plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) HashLockConfig() (*HashLockConfig, error) {
+	r, err := c.sectionReader(HashLockPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &HashLockConfig{
+		LockedFundsPerAggregate: r.amount("lockedFundsPerAggregate"),
+		MaxHashLockDuration:     r.duration("maxHashLockDuration"),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of secret lock plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) SecretLockConfig() (*SecretLockConfig, error) {
+	r, err := c.sectionReader(SecretLockPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &SecretLockConfig{
+		MaxSecretLockDuration: r.duration("maxSecretLockDuration"),
+		MinProofSize:          uint16(r.uint("minProofSize", 16)),
+		MaxProofSize:          uint16(r.uint("maxProofSize", 16)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of contract plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) ContractConfig() (*ContractConfig, error) {
+	r, err := c.sectionReader(ContractPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &ContractConfig{
+		MinPercentageOfApproval: uint8(r.uint("minPercentageOfApproval", 8)),
+		MinPercentageOfRemoval:  uint8(r.uint("minPercentageOfRemoval", 8)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// returns typed view of metadata plugin section, it fails if some field is missing or invalid
+func (c *NetworkConfig) MetadataConfig() (*MetadataConfig, error) {
+	r, err := c.sectionReader(MetadataPluginSection)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &MetadataConfig{
+		MaxFields:         uint8(r.uint("maxFields", 8)),
+		MaxFieldKeySize:   uint8(r.uint("maxFieldKeySize", 8)),
+		MaxFieldValueSize: uint16(r.uint("maxFieldValueSize", 16)),
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return conf, conf.Validate()
+}
+
+// validates every well-known section, which is present in config, sections unknown to SDK are not checked
+// it can be used before config is announced by NetworkConfigTransaction
+func (c *NetworkConfig) Validate() error {
+	views := []struct {
+		section string
+		read    func() error
+	}{
+		{ChainConfigSection, func() (err error) { _, err = c.ChainConfig(); return }},
+		{TransferPluginSection, func() (err error) { _, err = c.TransferConfig(); return }},
+		{NamespacePluginSection, func() (err error) { _, err = c.NamespaceConfig(); return }},
+		{MosaicPluginSection, func() (err error) { _, err = c.MosaicConfig(); return }},
+		{MultisigPluginSection, func() (err error) { _, err = c.MultisigConfig(); return }},
+		{AggregatePluginSection, func() (err error) { _, err = c.AggregateConfig(); return }},
+		{HashLockPluginSection, func() (err error) { _, err = c.HashLockConfig(); return }},
+		{SecretLockPluginSection, func() (err error) { _, err = c.SecretLockConfig(); return }},
+		{ContractPluginSection, func() (err error) { _, err = c.ContractConfig(); return }},
+		{MetadataPluginSection, func() (err error) { _, err = c.MetadataConfig(); return }},
+	}
+
+	for _, view := range views {
+		if _, ok := c.Sections[view.section]; !ok {
+			continue
+		}
+
+		if err := view.read(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validation of typed views returns ErrInvalidNetworkConfigValue, which describes the first invalid value
+func (c *ChainConfig) Validate() error {
+	v := configValidator{section: ChainConfigSection}
+	v.check(c.BlockGenerationTargetTime > 0, "blockGenerationTargetTime", "should be positive")
+	v.check(c.ImportanceGrouping > 0, "importanceGrouping", "should be positive")
+	v.check(c.MaxTransactionLifetime > 0, "maxTransactionLifetime", "should be positive")
+	v.check(c.MaxMosaicAtomicUnits > 0, "maxMosaicAtomicUnits", "should be positive")
+	v.check(c.MinHarvesterBalance <= c.MaxMosaicAtomicUnits, "minHarvesterBalance", "should not exceed maxMosaicAtomicUnits")
+	v.check(c.HarvestBeneficiaryPercentage <= 100, "harvestBeneficiaryPercentage", "should not exceed 100")
+	return v.err
+}
+
+func (c *TransferConfig) Validate() error {
+	return nil
+}
+
+func (c *NamespaceConfig) Validate() error {
+	v := configValidator{section: NamespacePluginSection}
+	v.check(c.MaxNameSize > 0, "maxNameSize", "should be positive")
+	v.check(c.MaxNamespaceDuration > 0, "maxNamespaceDuration", "should be positive")
+	v.check(c.MaxChildNamespaces > 0, "maxChildNamespaces", "should be positive")
+	return v.err
+}
+
+func (c *MosaicConfig) Validate() error {
+	v := configValidator{section: MosaicPluginSection}
+	v.check(c.MaxMosaicsPerAccount > 0, "maxMosaicsPerAccount", "should be positive")
+	v.check(c.MaxMosaicDuration > 0, "maxMosaicDuration", "should be positive")
+	return v.err
+}
+
+func (c *MultisigConfig) Validate() error {
+	v := configValidator{section: MultisigPluginSection}
+	v.check(c.MaxMultisigDepth > 0, "maxMultisigDepth", "should be positive")
+	v.check(c.MaxCosignersPerAccount > 0, "maxCosignersPerAccount", "should be positive")
+	v.check(c.MaxCosignedAccountsPerAccount > 0, "maxCosignedAccountsPerAccount", "should be positive")
+	return v.err
+}
+
+func (c *AggregateConfig) Validate() error {
+	v := configValidator{section: AggregatePluginSection}
+	v.check(c.MaxTransactionsPerAggregate > 0, "maxTransactionsPerAggregate", "should be positive")
+	v.check(c.MaxCosignaturesPerAggregate > 0, "maxCosignaturesPerAggregate", "should be positive")
+	v.check(!c.EnableBondedAggregateSupport || c.MaxBondedTransactionLifetime > 0, "maxBondedTransactionLifetime", "should be positive if bonded aggregates are enabled")
+	return v.err
+}
+
+func (c *HashLockConfig) Validate() error {
+	v := configValidator{section: HashLockPluginSection}
+	v.check(c.MaxHashLockDuration > 0, "maxHashLockDuration", "should be positive")
+	return v.err
+}
+
+func (c *SecretLockConfig) Validate() error {
+	v := configValidator{section: SecretLockPluginSection}
+	v.check(c.MaxSecretLockDuration > 0, "maxSecretLockDuration", "should be positive")
+	v.check(c.MinProofSize <= c.MaxProofSize, "minProofSize", "should not exceed maxProofSize")
+	return v.err
+}
+
+func (c *ContractConfig) Validate() error {
+	v := configValidator{section: ContractPluginSection}
+	v.check(c.MinPercentageOfApproval <= 100, "minPercentageOfApproval", "should not exceed 100")
+	v.check(c.MinPercentageOfRemoval <= 100, "minPercentageOfRemoval", "should not exceed 100")
+	return v.err
+}
+
+func (c *MetadataConfig) Validate() error {
+	v := configValidator{section: MetadataPluginSection}
+	v.check(c.MaxFields > 0, "maxFields", "should be positive")
+	v.check(c.MaxFieldKeySize > 0, "maxFieldKeySize", "should be positive")
+	v.check(c.MaxFieldValueSize > 0, "maxFieldValueSize", "should be positive")
+	return v.err
+}
+
+// configSectionReader parses fields of network config section and keeps the first error
+// values of failed fields are zero, so result should be used only if there is no error
+// missing optional fields are not an error, their values are zero and it is up to Validate to check them
+type configSectionReader struct {
+	section  *ConfigBag
+	optional map[string]bool
+	err      error
+}
+
+func (c *NetworkConfig) sectionReader(name string, optional ...string) (*configSectionReader, error) {
+	section, ok := c.Sections[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", name, ErrNetworkConfigSectionNotFound)
+	}
+
+	r := &configSectionReader{section: section, optional: make(map[string]bool, len(optional))}
+	for _, key := range optional {
+		r.optional[key] = true
+	}
+
+	return r, nil
+}
+
+// returns value of field, ok is false if field is missing or previous field failed
+func (r *configSectionReader) value(key string) (string, bool) {
+	if r.err != nil {
+		return "", false
+	}
+
+	field, ok := r.section.Fields[key]
+	if !ok {
+		if r.optional[key] {
+			return "", false
+		}
+
+		r.err = fmt.Errorf("%s.%s: %w", r.section.Name, key, ErrNetworkConfigFieldNotFound)
+		return "", false
+	}
+
+	return field.Value, true
+}
+
+func (r *configSectionReader) invalid(key, value string) {
+	r.err = fmt.Errorf("%w: %s.%s = %s", ErrInvalidNetworkConfigValue, r.section.Name, key, value)
+}
+
+// parses number with optional digit separators like 1'000'000
+func (r *configSectionReader) uint(key string, bitSize int) uint64 {
+	value, ok := r.value(key)
+	if !ok {
+		return 0
+	}
+
+	n, err := strconv.ParseUint(strings.Replace(value, "'", "", -1), 10, bitSize)
+	if err != nil {
+		r.invalid(key, value)
+	}
+
+	return n
+}
+
+func (r *configSectionReader) amount(key string) Amount {
+	return Amount(r.uint(key, 63))
+}
+
+func (r *configSectionReader) duration(key string) time.Duration {
+	value, ok := r.value(key)
+	if !ok {
+		return 0
+	}
+
+	d, err := parseConfigDuration(value)
+	if err != nil {
+		r.invalid(key, value)
+	}
+
+	return d
+}
+
+func (r *configSectionReader) bool(key string) bool {
+	value, ok := r.value(key)
+	if !ok {
+		return false
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		r.invalid(key, value)
+	}
+
+	return b
+}
+
+// parses hexadecimal mosaic id like 0x0DC6'7FBE'1CAD'29E3
+func (r *configSectionReader) mosaicId(key string) *MosaicId {
+	value, ok := r.value(key)
+	if !ok {
+		return nil
+	}
+
+	id, err := strconv.ParseUint(strings.Replace(strings.TrimPrefix(value, "0x"), "'", "", -1), 16, 64)
+	if err != nil {
+		r.invalid(key, value)
+		return nil
+	}
+
+	mosaicId, err := NewMosaicId(id)
+	if err != nil {
+		r.invalid(key, value)
+	}
+
+	return mosaicId
+}
+
+func (r *configSectionReader) publicKey(key string) string {
+	value, ok := r.value(key)
+	if !ok {
+		return ""
+	}
+
+	if b, err := hex.DecodeString(value); err != nil || len(b) != 32 {
+		r.invalid(key, value)
+	}
+
+	return value
+}
+
+// parses comma separated list, spaces around items are trimmed
+func (r *configSectionReader) list(key string) []string {
+	value, ok := r.value(key)
+	if !ok || value == "" {
+		return nil
+	}
+
+	items := strings.Split(value, ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+
+	return items
+}
+
+// configValidator keeps the first failed check of typed view of network config
+type configValidator struct {
+	section string
+	err     error
+}
+
+func (v *configValidator) check(ok bool, key, reason string) {
+	if !ok && v.err == nil {
+		v.err = fmt.Errorf("%w: %s.%s %s", ErrInvalidNetworkConfigValue, v.section, key, reason)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testNetworkConfigSinkKey = "53E140B5947F104CABC2D6FE8BAEDBC30EF9A0609C717D9613DE593EC2A266D3"
+
+	testNetworkConfigText = `[network]
+
+identifier = mijin-test
+
+[chain]
+
+currencyMosaicId = 0x0DC6'7FBE'1CAD'29E3
+harvestingMosaicId = 0x26B6'4CBA'2E33'3C8A
+blockGenerationTargetTime = 15s
+blockTimeSmoothingFactor = 3000
+importanceGrouping = 7
+maxRollbackBlocks = 360
+maxDifficultyBlocks = 3
+maxTransactionLifetime = 24h
+maxBlockFutureTime = 10s
+maxMosaicAtomicUnits = 9'000'000'000'000'000
+totalChainImportance = 8'999'999'998'000'000
+minHarvesterBalance = 1'000'000'000'000
+harvestBeneficiaryPercentage = 10
+blockPruneInterval = 360
+maxTransactionsPerBlock = 200'000
+
+[plugin:catapult.plugins.aggregate]
+
+maxTransactionsPerAggregate = 1'000
+maxCosignaturesPerAggregate = 15
+enableStrictCosignatureCheck = false
+enableBondedAggregateSupport = true
+maxBondedTransactionLifetime = 48h
+
+[plugin:catapult.plugins.lockhash]
+
+lockedFundsPerAggregate = 10'000'000
+maxHashLockDuration = 2d
+
+[plugin:catapult.plugins.locksecret]
+
+maxSecretLockDuration = 30d
+minProofSize = 1
+maxProofSize = 1000
+
+[plugin:catapult.plugins.metadata]
+
+maxFields = 10
+maxFieldKeySize = 128
+maxFieldValueSize = 1024
+
+[plugin:catapult.plugins.mosaic]
+
+maxMosaicsPerAccount = 10'000
+maxMosaicDuration = 3650d
+maxMosaicDivisibility = 6
+mosaicRentalFeeSinkPublicKey = 53E140B5947F104CABC2D6FE8BAEDBC30EF9A0609C717D9613DE593EC2A266D3
+mosaicRentalFee = 500
+
+[plugin:catapult.plugins.multisig]
+
+maxMultisigDepth = 3
+maxCosignersPerAccount = 10
+maxCosignedAccountsPerAccount = 5
+
+[plugin:catapult.plugins.namespace]
+
+maxNameSize = 64
+maxNamespaceDuration = 365d
+namespaceGracePeriodDuration = 0d
+reservedRootNamespaceNames = xem, nem, user, account
+namespaceRentalFeeSinkPublicKey = 53E140B5947F104CABC2D6FE8BAEDBC30EF9A0609C717D9613DE593EC2A266D3
+rootNamespaceRentalFeePerBlock = 1
+childNamespaceRentalFee = 100
+maxChildNamespaces = 500
+
+[plugin:catapult.plugins.transfer]
+
+maxMessageSize = 1024
+
+[plugin:catapult.plugins.contract]
+
+minPercentageOfApproval = 100
+minPercentageOfRemoval = 66
+`
+)
+
+func newTestNetworkConfig(t *testing.T, text string) *NetworkConfig {
+	conf := NewNetworkConfig()
+	assert.Nil(t, conf.UnmarshalBinary([]byte(text)))
+
+	return conf
+}
+
+func TestNetworkConfig_TypedViews(t *testing.T) {
+	conf := newTestNetworkConfig(t, testNetworkConfigText)
+
+	chain, err := conf.ChainConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &ChainConfig{
+		CurrencyMosaicId:             newMosaicIdPanic(0x0DC67FBE1CAD29E3),
+		HarvestingMosaicId:           newMosaicIdPanic(0x26B64CBA2E333C8A),
+		BlockGenerationTargetTime:    15 * time.Second,
+		BlockTimeSmoothingFactor:     3000,
+		ImportanceGrouping:           7,
+		MaxRollbackBlocks:            360,
+		MaxDifficultyBlocks:          3,
+		MaxTransactionLifetime:       24 * time.Hour,
+		MaxBlockFutureTime:           10 * time.Second,
+		MaxMosaicAtomicUnits:         9000000000000000,
+		TotalChainImportance:         8999999998000000,
+		MinHarvesterBalance:          1000000000000,
+		HarvestBeneficiaryPercentage: 10,
+		BlockPruneInterval:           360,
+		MaxTransactionsPerBlock:      200000,
+	}, chain)
+
+	transfer, err := conf.TransferConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &TransferConfig{MaxMessageSize: 1024}, transfer)
+
+	namespace, err := conf.NamespaceConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &NamespaceConfig{
+		MaxNameSize:                     64,
+		MaxNamespaceDuration:            365 * 24 * time.Hour,
+		ReservedRootNamespaceNames:      []string{"xem", "nem", "user", "account"},
+		NamespaceRentalFeeSinkPublicKey: testNetworkConfigSinkKey,
+		RootNamespaceRentalFeePerBlock:  1,
+		ChildNamespaceRentalFee:         100,
+		MaxChildNamespaces:              500,
+	}, namespace)
+
+	mosaic, err := conf.MosaicConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &MosaicConfig{
+		MaxMosaicsPerAccount:         10000,
+		MaxMosaicDuration:            3650 * 24 * time.Hour,
+		MaxMosaicDivisibility:        6,
+		MosaicRentalFeeSinkPublicKey: testNetworkConfigSinkKey,
+		MosaicRentalFee:              500,
+	}, mosaic)
+
+	multisig, err := conf.MultisigConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &MultisigConfig{MaxMultisigDepth: 3, MaxCosignersPerAccount: 10, MaxCosignedAccountsPerAccount: 5}, multisig)
+
+	aggregate, err := conf.AggregateConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &AggregateConfig{
+		MaxTransactionsPerAggregate:  1000,
+		MaxCosignaturesPerAggregate:  15,
+		EnableBondedAggregateSupport: true,
+		MaxBondedTransactionLifetime: 48 * time.Hour,
+	}, aggregate)
+
+	hashLock, err := conf.HashLockConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &HashLockConfig{LockedFundsPerAggregate: 10000000, MaxHashLockDuration: 48 * time.Hour}, hashLock)
+
+	secretLock, err := conf.SecretLockConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &SecretLockConfig{MaxSecretLockDuration: 30 * 24 * time.Hour, MinProofSize: 1, MaxProofSize: 1000}, secretLock)
+
+	contract, err := conf.ContractConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &ContractConfig{MinPercentageOfApproval: 100, MinPercentageOfRemoval: 66}, contract)
+
+	metadata, err := conf.MetadataConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, &MetadataConfig{MaxFields: 10, MaxFieldKeySize: 128, MaxFieldValueSize: 1024}, metadata)
+
+	assert.Nil(t, conf.Validate())
+}
+
+func TestNetworkConfig_OptionalFields(t *testing.T) {
+	text := testNetworkConfigText
+	for _, field := range []string{"blockPruneInterval = 360\n", "maxTransactionsPerBlock = 200'000\n", "enableBondedAggregateSupport = true\n", "maxBondedTransactionLifetime = 48h\n"} {
+		text = strings.Replace(text, field, "", 1)
+	}
+
+	conf := newTestNetworkConfig(t, text)
+	assert.Nil(t, conf.Validate())
+
+	chain, err := conf.ChainConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), chain.BlockPruneInterval)
+	assert.Equal(t, uint32(0), chain.MaxTransactionsPerBlock)
+
+	aggregate, err := conf.AggregateConfig()
+	assert.Nil(t, err)
+	assert.False(t, aggregate.EnableBondedAggregateSupport)
+	assert.Equal(t, time.Duration(0), aggregate.MaxBondedTransactionLifetime)
+
+	_, err = newTestNetworkConfig(t, strings.Replace(testNetworkConfigText, "maxBondedTransactionLifetime = 48h\n", "", 1)).AggregateConfig()
+	assert.True(t, errors.Is(err, ErrInvalidNetworkConfigValue))
+}
+
+func TestNetworkConfig_TypedViewErrors(t *testing.T) {
+	_, err := NewNetworkConfig().TransferConfig()
+	assert.True(t, errors.Is(err, ErrNetworkConfigSectionNotFound))
+
+	tests := []struct {
+		name    string
+		replace [2]string
+		err     error
+		text    string
+	}{
+		{"missing field", [2]string{"maxMessageSize = 1024", ""}, ErrNetworkConfigFieldNotFound, "plugin:catapult.plugins.transfer.maxMessageSize"},
+		{"wrong number", [2]string{"maxCosignaturesPerAggregate = 15", "maxCosignaturesPerAggregate = 300"}, ErrInvalidNetworkConfigValue, "maxCosignaturesPerAggregate = 300"},
+		{"wrong duration", [2]string{"maxHashLockDuration = 2d", "maxHashLockDuration = 2"}, ErrInvalidNetworkConfigValue, "maxHashLockDuration = 2"},
+		{"wrong mosaic id", [2]string{"0x0DC6'7FBE'1CAD'29E3", "0xFFC6'7FBE'1CAD'29E3"}, ErrInvalidNetworkConfigValue, "currencyMosaicId"},
+		{"wrong public key", [2]string{"mosaicRentalFeeSinkPublicKey = 53E1", "mosaicRentalFeeSinkPublicKey = XYZ"}, ErrInvalidNetworkConfigValue, "mosaicRentalFeeSinkPublicKey"},
+		{"proof sizes", [2]string{"minProofSize = 1", "minProofSize = 1001"}, ErrInvalidNetworkConfigValue, "minProofSize should not exceed maxProofSize"},
+		{"percentage", [2]string{"minPercentageOfRemoval = 66", "minPercentageOfRemoval = 166"}, ErrInvalidNetworkConfigValue, "minPercentageOfRemoval should not exceed 100"},
+		{"zero limit", [2]string{"maxFields = 10", "maxFields = 0"}, ErrInvalidNetworkConfigValue, "maxFields should be positive"},
+	}
+
+	for _, tt := range tests {
+		conf := newTestNetworkConfig(t, strings.Replace(testNetworkConfigText, tt.replace[0], tt.replace[1], 1))
+
+		err := conf.Validate()
+		assert.True(t, errors.Is(err, tt.err), "%s: %v", tt.name, err)
+		assert.Contains(t, err.Error(), tt.text, tt.name)
+	}
+}
@@ -5,7 +5,11 @@
 package sdk
 
 import (
+	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type networkDTO struct {
@@ -92,3 +96,39 @@ func (dto *networkVersionDTO) toStruct() *NetworkVersion {
 		BlockChainVersion(dto.DTO.BlockChainVersion.toUint64()),
 	}
 }
+
+var configDurationUnits = []struct {
+	suffix string
+	unit   time.Duration
+}{
+	{"ms", time.Millisecond},
+	{"s", time.Second},
+	{"m", time.Minute},
+	{"h", time.Hour},
+	{"d", 24 * time.Hour},
+}
+
+// parses duration of network config in format like '15s', '500ms', '1h' or '365d', digit separators like 1'000ms are allowed
+func parseConfigDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	for _, u := range configDurationUnits {
+		if !strings.HasSuffix(value, u.suffix) {
+			continue
+		}
+
+		// 'ms' also ends with 's', so the rest should be checked to be a number
+		n, err := strconv.ParseUint(strings.Replace(strings.TrimSuffix(value, u.suffix), "'", "", -1), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if n > uint64(math.MaxInt64/u.unit) {
+			return 0, fmt.Errorf("duration value in network config overflows: %s", value)
+		}
+
+		return time.Duration(n) * u.unit, nil
+	}
+
+	return 0, fmt.Errorf("wrong duration value in network config: %s", value)
+}
@@ -12,6 +12,7 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/proximax-storage/go-xpx-utils/str"
 )
@@ -247,6 +248,21 @@ func (c *NetworkConfig) MarshalBinary() (data []byte, err error) {
 	return []byte(s), nil
 }
 
+// returns value of blockGenerationTargetTime field from chain section
+func (c *NetworkConfig) BlockGenerationTargetTime() (time.Duration, error) {
+	chain, ok := c.Sections["chain"]
+	if !ok {
+		return 0, ErrNetworkConfigSectionNotFound
+	}
+
+	field, ok := chain.Fields["blockGenerationTargetTime"]
+	if !ok {
+		return 0, ErrNetworkConfigFieldNotFound
+	}
+
+	return parseConfigDuration(field.Value)
+}
+
 func (c *NetworkConfig) String() string {
 	s, _ := c.MarshalBinary()
 
@@ -7,6 +7,7 @@ package sdk
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/proximax-storage/go-xpx-utils/mock"
 	"github.com/proximax-storage/go-xpx-utils/tests"
@@ -180,3 +181,35 @@ func TestNetworkService_GetNetworkConfigAtHeight(t *testing.T) {
 	assert.Nil(t, err)
 	tests.ValidateStringers(t, networkConfig, nConfig)
 }
+
+func TestParseConfigDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"15s":     15 * time.Second,
+		"500ms":   500 * time.Millisecond,
+		"10m":     10 * time.Minute,
+		"1h":      time.Hour,
+		"365d":    365 * 24 * time.Hour,
+		"1'000ms": time.Second,
+	} {
+		d, err := parseConfigDuration(value)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, d)
+	}
+
+	for _, value := range []string{"15", "1'000'000'000d", "18446744073709551615ms"} {
+		_, err := parseConfigDuration(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestNetworkConfig_BlockGenerationTargetTime(t *testing.T) {
+	conf := NewNetworkConfig()
+	assert.Nil(t, conf.UnmarshalBinary([]byte("[chain]\n\nblockGenerationTargetTime = 15s\n")))
+
+	d, err := conf.BlockGenerationTargetTime()
+	assert.Nil(t, err)
+	assert.Equal(t, 15*time.Second, d)
+
+	_, err = NewNetworkConfig().BlockGenerationTargetTime()
+	assert.Equal(t, ErrNetworkConfigSectionNotFound, err)
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"encoding/hex"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Observer receives events of Client, methods are called synchronously, so they should not block
+type Observer interface {
+	// called after every attempt of request
+	ObserveRequest(info *RequestInfo)
+	// called when request failed on node `from` is retried on node `to`
+	ObserveRetry(from, to *url.URL, err error)
+	// called when client switches to node `to` after request is succeeded on it
+	ObserveFailover(from, to *url.URL)
+}
+
+// RequestInfo describes attempt of request to REST server
+// `Route` is path of request, where segments with identifiers, e.g. addresses, hashes and heights, are replaced by ":id"
+// `StatusCode` is zero if response is not received
+type RequestInfo struct {
+	Method        string
+	Route         string
+	Host          string
+	StatusCode    int
+	Duration      time.Duration
+	BytesSent     int64
+	BytesReceived int64
+	Err           error
+}
+
+// sets observer of requests, retries and failovers, it should be called before client is used
+// websocket clients don't share it, it should be passed to their SetObserver explicitly
+func (c *Client) SetObserver(o Observer) {
+	c.observer = o
+}
+
+// returns path with identifiers replaced by ":id", so it can be used as low cardinality label
+// segments with digits are considered identifiers, because all static segments of routes consist of letters,
+// hex segments of the length of id or hash are identifiers too, because they can consist of letters only
+func routeOf(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		if strings.IndexFunc(segment, unicode.IsDigit) >= 0 || isHexId(segment) {
+			segments[i] = ":id"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// returns true if segment is hex string of the length of 8 bytes id or 32 bytes hash
+func isHexId(segment string) bool {
+	if len(segment) != 16 && len(segment) != 64 {
+		return false
+	}
+
+	_, err := hex.DecodeString(segment)
+	return err == nil
+}
+
+// countingReader counts bytes read from response body
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n += int64(n)
+
+	return n, err
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testObserver struct {
+	requests  []*RequestInfo
+	retries   []string
+	failovers []string
+}
+
+func (o *testObserver) ObserveRequest(info *RequestInfo) {
+	o.requests = append(o.requests, info)
+}
+
+func (o *testObserver) ObserveRetry(from, to *url.URL, err error) {
+	o.retries = append(o.retries, from.Host+" "+to.Host)
+}
+
+func (o *testObserver) ObserveFailover(from, to *url.URL) {
+	o.failovers = append(o.failovers, from.Host+" "+to.Host)
+}
+
+func TestClient_Observer(t *testing.T) {
+	body := `{"height": [42, 0]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	conf, err := NewConfigWithReputation([]string{down.URL, server.URL}, PublicTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, nil, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	observer := &testObserver{}
+
+	client := NewClient(nil, conf)
+	client.SetObserver(observer)
+
+	height, err := client.Blockchain.GetBlockchainHeight(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, Height(42), height)
+
+	downHost, serverHost := conf.BaseURLs[0].Host, conf.BaseURLs[1].Host
+
+	assert.Len(t, observer.requests, 2)
+	assert.Equal(t, downHost, observer.requests[0].Host)
+	assert.Equal(t, 0, observer.requests[0].StatusCode)
+	assert.NotNil(t, observer.requests[0].Err)
+
+	assert.Equal(t, http.MethodGet, observer.requests[1].Method)
+	assert.Equal(t, "/chain/height", observer.requests[1].Route)
+	assert.Equal(t, serverHost, observer.requests[1].Host)
+	assert.Equal(t, http.StatusOK, observer.requests[1].StatusCode)
+	assert.Equal(t, int64(len(body)), observer.requests[1].BytesReceived)
+	assert.Nil(t, observer.requests[1].Err)
+
+	assert.Equal(t, []string{downHost + " " + serverHost}, observer.retries)
+	assert.Equal(t, []string{downHost + " " + serverHost}, observer.failovers)
+	assert.Equal(t, serverHost, conf.GetUsedBaseUrl().Host)
+}
+
+func TestRouteOf(t *testing.T) {
+	assert.Equal(t, "/chain/height", routeOf("/chain/height"))
+	assert.Equal(t, "/blocks/:id/limit/:id", routeOf("/blocks/10/limit/100"))
+	assert.Equal(t, "/account/:id/lock/hash", routeOf("/account/SAONSOGFZZHNEIBRYXHDTDTBR2YSAXKTITRFHG2Y/lock/hash"))
+	assert.Equal(t, "/namespace/:id", routeOf("/namespace/abcdefabcdefabcd"))
+	assert.Equal(t, "/transaction/:id/status", routeOf("/transaction/"+strings.Repeat("AB", 32)+"/status"))
+	assert.Equal(t, "/namespace/names", routeOf("/namespace/names"))
+}
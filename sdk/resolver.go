@@ -6,7 +6,6 @@ package sdk
 
 import (
 	"context"
-	"errors"
 )
 
 // TODO: Implement resolving namespace to account
@@ -31,7 +30,7 @@ func (ref *ResolverService) GetMosaicInfoByAssetId(ctx context.Context, assetId
 		}
 
 		if namespaceInfo.Alias == nil || namespaceInfo.Alias.MosaicId() == nil {
-			return nil, errors.New("Namespace is not aliased to Mosaic")
+			return nil, ErrNamespaceNotAliasedToMosaic
 		}
 
 		return ref.MosaicService.GetMosaicInfo(ctx, namespaceInfo.Alias.MosaicId())
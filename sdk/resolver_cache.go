@@ -0,0 +1,309 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const DefaultResolverCacheTTL = time.Minute
+
+// ResolverCache caches MosaicInfo's, NamespaceInfo's, namespace names and alias links
+// requested from the node, so the same ids are not resolved through the network over and over again.
+// It is safe for concurrent use by multiple goroutines.
+type ResolverCache struct {
+	client *Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	sync.RWMutex
+	mosaicInfos    map[uint64]*cacheEntry
+	namespaceInfos map[uint64]*cacheEntry
+	namespaceNames map[uint64]*cacheEntry
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func (e *cacheEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// returns new ResolverCache, which requests missed values through passed Client
+// entries are kept for passed ttl, zero ttl means that entries live until they are invalidated
+func NewResolverCache(client *Client, ttl time.Duration) *ResolverCache {
+	return &ResolverCache{
+		client:         client,
+		ttl:            ttl,
+		now:            time.Now,
+		mosaicInfos:    make(map[uint64]*cacheEntry),
+		namespaceInfos: make(map[uint64]*cacheEntry),
+		namespaceNames: make(map[uint64]*cacheEntry),
+	}
+}
+
+// returns MosaicInfo for passed MosaicId from cache or from the node
+func (c *ResolverCache) GetMosaicInfo(ctx context.Context, mosaicId *MosaicId) (*MosaicInfo, error) {
+	if mosaicId == nil {
+		return nil, ErrNilMosaicId
+	}
+
+	if v, ok := c.load(c.mosaicInfos, mosaicId.Id()); ok {
+		return v.(*MosaicInfo), nil
+	}
+
+	info, err := c.client.Mosaic.GetMosaicInfo(ctx, mosaicId)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(c.mosaicInfos, mosaicId.Id(), info)
+
+	return info, nil
+}
+
+// returns MosaicInfo's for passed MosaicId's, missed in cache infos are requested from the node by one batch
+func (c *ResolverCache) GetMosaicInfos(ctx context.Context, mscIds []*MosaicId) ([]*MosaicInfo, error) {
+	if len(mscIds) == 0 {
+		return nil, ErrEmptyMosaicIds
+	}
+
+	infos := make(map[uint64]*MosaicInfo, len(mscIds))
+	missed := make([]*MosaicId, 0)
+
+	for _, mscId := range mscIds {
+		if mscId == nil {
+			return nil, ErrNilMosaicId
+		}
+
+		if v, ok := c.load(c.mosaicInfos, mscId.Id()); ok {
+			infos[mscId.Id()] = v.(*MosaicInfo)
+		} else {
+			missed = append(missed, mscId)
+		}
+	}
+
+	if len(missed) > 0 {
+		requested, err := c.client.Mosaic.GetMosaicInfos(ctx, missed)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range requested {
+			infos[info.MosaicId.Id()] = info
+			c.store(c.mosaicInfos, info.MosaicId.Id(), info)
+		}
+	}
+
+	result := make([]*MosaicInfo, 0, len(infos))
+	for _, mscId := range mscIds {
+		if info, ok := infos[mscId.Id()]; ok {
+			result = append(result, info)
+		}
+	}
+
+	return result, nil
+}
+
+// returns NamespaceInfo for passed NamespaceId from cache or from the node
+func (c *ResolverCache) GetNamespaceInfo(ctx context.Context, nsId *NamespaceId) (*NamespaceInfo, error) {
+	if nsId == nil {
+		return nil, ErrNilNamespaceId
+	}
+
+	if v, ok := c.load(c.namespaceInfos, nsId.Id()); ok {
+		return v.(*NamespaceInfo), nil
+	}
+
+	info, err := c.client.Namespace.GetNamespaceInfo(ctx, nsId)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(c.namespaceInfos, nsId.Id(), info)
+
+	return info, nil
+}
+
+// returns NamespaceName's for passed NamespaceId's, missed in cache names are requested from the node by one batch
+func (c *ResolverCache) GetNamespaceNames(ctx context.Context, nsIds []*NamespaceId) ([]*NamespaceName, error) {
+	if len(nsIds) == 0 {
+		return nil, ErrEmptyNamespaceIds
+	}
+
+	names := make(map[uint64]*NamespaceName, len(nsIds))
+	missed := make([]*NamespaceId, 0)
+
+	for _, nsId := range nsIds {
+		if nsId == nil {
+			return nil, ErrNilNamespaceId
+		}
+
+		if v, ok := c.load(c.namespaceNames, nsId.Id()); ok {
+			names[nsId.Id()] = v.(*NamespaceName)
+		} else {
+			missed = append(missed, nsId)
+		}
+	}
+
+	if len(missed) > 0 {
+		requested, err := c.client.Namespace.GetNamespaceNames(ctx, missed)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range requested {
+			names[name.NamespaceId.Id()] = name
+			c.store(c.namespaceNames, name.NamespaceId.Id(), name)
+		}
+	}
+
+	result := make([]*NamespaceName, 0, len(names))
+	for _, nsId := range nsIds {
+		if name, ok := names[nsId.Id()]; ok {
+			result = append(result, name)
+		}
+	}
+
+	return result, nil
+}
+
+// returns MosaicId aliased by passed NamespaceId
+func (c *ResolverCache) GetLinkedMosaicId(ctx context.Context, nsId *NamespaceId) (*MosaicId, error) {
+	info, err := c.GetNamespaceInfo(ctx, nsId)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Alias == nil || info.Alias.MosaicId() == nil {
+		return nil, ErrNamespaceNotAliasedToMosaic
+	}
+
+	return info.Alias.MosaicId(), nil
+}
+
+// returns Address aliased by passed NamespaceId
+func (c *ResolverCache) GetLinkedAddress(ctx context.Context, nsId *NamespaceId) (*Address, error) {
+	info, err := c.GetNamespaceInfo(ctx, nsId)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Alias == nil || info.Alias.Address() == nil {
+		return nil, ErrNamespaceNotAliasedToAddress
+	}
+
+	return info.Alias.Address(), nil
+}
+
+// returns MosaicInfo for passed AssetId resolving namespace alias if it is needed
+func (c *ResolverCache) GetMosaicInfoByAssetId(ctx context.Context, assetId AssetId) (*MosaicInfo, error) {
+	if assetId == nil {
+		return nil, ErrNilAssetId
+	}
+
+	switch assetId.Type() {
+	case NamespaceAssetIdType:
+		mosaicId, err := c.GetLinkedMosaicId(ctx, assetId.(*NamespaceId))
+		if err != nil {
+			return nil, err
+		}
+
+		return c.GetMosaicInfo(ctx, mosaicId)
+	case MosaicAssetIdType:
+		return c.GetMosaicInfo(ctx, assetId.(*MosaicId))
+	}
+
+	return nil, ErrUnknownBlockchainType
+}
+
+// removes all cached values for passed AssetId
+func (c *ResolverCache) Invalidate(assetId AssetId) {
+	if assetId == nil {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	switch assetId.Type() {
+	case NamespaceAssetIdType:
+		delete(c.namespaceInfos, assetId.Id())
+		delete(c.namespaceNames, assetId.Id())
+	case MosaicAssetIdType:
+		delete(c.mosaicInfos, assetId.Id())
+	}
+}
+
+// removes all cached values
+func (c *ResolverCache) InvalidateAll() {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, storage := range []map[uint64]*cacheEntry{c.mosaicInfos, c.namespaceInfos, c.namespaceNames} {
+		for id := range storage {
+			delete(storage, id)
+		}
+	}
+}
+
+// invalidates cache with passed block
+// namespaces, mosaics and aliases can be changed only by transactions, so block with transactions invalidates the whole cache
+// empty block invalidates only namespaces, which are expired at the height of the block
+func (c *ResolverCache) InvalidateWithBlock(block *BlockInfo) {
+	if block == nil {
+		return
+	}
+
+	if block.NumTransactions > 0 {
+		c.InvalidateAll()
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	for id, entry := range c.namespaceInfos {
+		if info := entry.value.(*NamespaceInfo); uint64(info.EndHeight) <= uint64(block.Height) {
+			delete(c.namespaceInfos, id)
+		}
+	}
+}
+
+// returns function, which can be registered as websocket block handler to invalidate cache with every new block
+func (c *ResolverCache) BlockHandler() func(*BlockInfo) bool {
+	return func(block *BlockInfo) bool {
+		c.InvalidateWithBlock(block)
+		return false
+	}
+}
+
+func (c *ResolverCache) load(storage map[uint64]*cacheEntry, id uint64) (interface{}, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	entry, ok := storage[id]
+	if !ok || entry.expired(c.now()) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (c *ResolverCache) store(storage map[uint64]*cacheEntry, id uint64, value interface{}) {
+	entry := &cacheEntry{value: value}
+	if c.ttl > 0 {
+		entry.expiresAt = c.now().Add(c.ttl)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	storage[id] = entry
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCountingSdkMock(paths map[string]string) (*sdkMock, map[string]*int32) {
+	mockServ := newSdkMock(0)
+	counters := make(map[string]*int32, len(paths))
+
+	for path, body := range paths {
+		counter := new(int32)
+		counters[path] = counter
+
+		body := body
+		mockServ.AddHandler(path, func(resp http.ResponseWriter, req *http.Request) {
+			atomic.AddInt32(counter, 1)
+			resp.Write([]byte(body))
+		})
+	}
+
+	return mockServ, counters
+}
+
+func TestResolverCache_GetMosaicInfoByAssetId(t *testing.T) {
+	nsPath := fmt.Sprintf(namespaceRoute, testNamespaceId.toHexString())
+	mscPath := fmt.Sprintf(mosaicRoute, namespaceCorr.Alias.MosaicId().toHexString())
+
+	mockServ, counters := newCountingSdkMock(map[string]string{
+		nsPath:  tplInfo,
+		mscPath: testMosaicInfoJson,
+	})
+	defer mockServ.Close()
+
+	cache := NewResolverCache(mockServ.getPublicTestClientUnsafe(), DefaultResolverCacheTTL)
+
+	for i := 0; i < 3; i++ {
+		info, err := cache.GetMosaicInfoByAssetId(ctx, testNamespaceId)
+		assert.Nilf(t, err, "ResolverCache.GetMosaicInfoByAssetId returned error: %s", err)
+		assert.Equal(t, mosaicCorr.MosaicId.Id(), info.MosaicId.Id())
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(counters[nsPath]))
+	assert.Equal(t, int32(1), atomic.LoadInt32(counters[mscPath]))
+
+	mosaicId, err := cache.GetLinkedMosaicId(ctx, testNamespaceId)
+	assert.Nil(t, err)
+	assert.Equal(t, namespaceCorr.Alias.MosaicId().Id(), mosaicId.Id())
+
+	_, err = cache.GetLinkedAddress(ctx, testNamespaceId)
+	assert.Equal(t, ErrNamespaceNotAliasedToAddress, err)
+
+	cache.Invalidate(testNamespaceId)
+
+	_, err = cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(counters[nsPath]))
+	assert.Equal(t, int32(1), atomic.LoadInt32(counters[mscPath]))
+}
+
+func TestResolverCache_TTL(t *testing.T) {
+	nsPath := fmt.Sprintf(namespaceRoute, testNamespaceId.toHexString())
+
+	mockServ, counters := newCountingSdkMock(map[string]string{
+		nsPath: tplInfo,
+	})
+	defer mockServ.Close()
+
+	now := time.Now()
+
+	cache := NewResolverCache(mockServ.getPublicTestClientUnsafe(), time.Minute)
+	cache.now = func() time.Time { return now }
+
+	_, err := cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+
+	now = now.Add(30 * time.Second)
+	_, err = cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(counters[nsPath]))
+
+	now = now.Add(time.Minute)
+	_, err = cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(counters[nsPath]))
+}
+
+func TestResolverCache_InvalidateWithBlock(t *testing.T) {
+	nsPath := fmt.Sprintf(namespaceRoute, testNamespaceId.toHexString())
+
+	mockServ, counters := newCountingSdkMock(map[string]string{
+		nsPath: tplInfo,
+	})
+	defer mockServ.Close()
+
+	cache := NewResolverCache(mockServ.getPublicTestClientUnsafe(), 0)
+	handler := cache.BlockHandler()
+
+	_, err := cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+
+	// empty block before namespace expiration keeps cache
+	assert.False(t, handler(&BlockInfo{Height: 100}))
+	_, err = cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(counters[nsPath]))
+
+	// block with transactions drops cache
+	assert.False(t, handler(&BlockInfo{Height: 101, NumTransactions: 1}))
+	_, err = cache.GetNamespaceInfo(ctx, testNamespaceId)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(counters[nsPath]))
+}
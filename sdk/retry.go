@@ -0,0 +1,92 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures retries of requests, which failed because node was unreachable
+// every attempt tries all BaseURLs, the first one skips used url, which has just failed
+// `Attempts` is one by default, `Delay` is waited between attempts
+type RetryPolicy struct {
+	Attempts int
+	Delay    time.Duration
+}
+
+// returns number of attempts and delay between them
+func (p *RetryPolicy) attempts() (int, time.Duration) {
+	if p == nil || p.Attempts < 1 {
+		return 1, 0
+	}
+
+	return p.Attempts, p.Delay
+}
+
+// sends request to other nodes after it failed on used one, the first responding node becomes used
+// nodes, which do not match pinned network identity of config, are skipped
+func (c *Client) failover(ctx context.Context, req *http.Request, v interface{}, err error) (*http.Response, error) {
+	attempts, delay := c.config.Retry.attempts()
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 && delay > 0 {
+			timer := time.NewTimer(delay)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, contextError(ctx)
+			case <-timer.C:
+			}
+		}
+
+		for _, url := range c.config.BaseURLs {
+			if attempt == 0 && c.config.GetUsedBaseUrl() == url {
+				continue
+			}
+
+			if ctx.Err() != nil {
+				return nil, contextError(ctx)
+			}
+
+			if c.checkNodeIdentity(ctx, url) != nil {
+				continue
+			}
+
+			if c.observer != nil {
+				c.observer.ObserveRetry(c.config.GetUsedBaseUrl(), url, err)
+			}
+
+			// body of request is consumed by the previous attempt
+			if req.GetBody != nil {
+				body, bodyErr := req.GetBody()
+				if bodyErr != nil {
+					return nil, bodyErr
+				}
+
+				req.Body = body
+			}
+
+			var resp *http.Response
+
+			req.URL.Host = url.Host
+			resp, err = c.do(ctx, req, v)
+			if err != nil {
+				continue
+			}
+
+			if used := c.config.GetUsedBaseUrl(); c.observer != nil && used != url {
+				c.observer.ObserveFailover(used, url)
+			}
+
+			c.config.SetUsedBaseUrl(url)
+			return resp, nil
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	var hosts, bodies []string
+
+	// the second node comes up after both nodes failed once
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		if req.Body != nil {
+			data, _ := ioutil.ReadAll(req.Body)
+			body = string(data)
+		}
+
+		hosts = append(hosts, req.URL.Host)
+		bodies = append(bodies, body)
+
+		if len(hosts) < 4 {
+			return nil, errors.New("connection refused")
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"message": "ok"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+
+	conf, err := NewConfigWithReputation(
+		[]string{"http://first:3000", "http://second:3000"},
+		MijinTest,
+		&defaultRepConfig,
+		DefaultWebsocketReconnectionTimeout,
+		nil,
+		DefaultFeeCalculationStrategy,
+	)
+	assert.Nil(t, err)
+
+	conf.Retry = &RetryPolicy{Attempts: 2, Delay: time.Millisecond}
+	client := NewClient(&http.Client{Transport: transport}, conf)
+
+	v := &struct{ Message string }{}
+	_, err = client.doNewRequest(context.Background(), http.MethodPut, "/transaction", &struct {
+		Payload string `json:"payload"`
+	}{"AB"}, v)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"first:3000", "second:3000", "first:3000", "second:3000"}, hosts)
+	for _, body := range bodies {
+		assert.Equal(t, "{\"payload\":\"AB\"}\n", body)
+	}
+
+	assert.Equal(t, "ok", v.Message)
+	assert.Equal(t, "second:3000", conf.GetUsedBaseUrl().Host)
+
+	hosts = nil
+	conf.Retry = nil
+	conf.UsedBaseUrl, _ = url.Parse("http://first:3000")
+	conf.BaseURLs[0] = conf.UsedBaseUrl
+
+	transport = func(req *http.Request) (*http.Response, error) {
+		hosts = append(hosts, req.URL.Host)
+		return nil, errors.New("connection refused")
+	}
+	client = NewClient(&http.Client{Transport: transport}, conf)
+
+	_, err = client.doNewRequest(context.Background(), http.MethodGet, "/chain/height", nil, v)
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"first:3000", "second:3000"}, hosts)
+}
@@ -9,10 +9,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -27,9 +29,47 @@ const (
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
+// HttpError is returned when REST server responds with unsuccessful status code
+// `Code` and `Message` are parsed from JSON body of response, error text is raw body if it is not JSON
+// it is equal for errors.Is to ErrResourceNotFound, ErrArgumentNotValid and ErrInvalidRequest according to its code
 type HttpError struct {
 	error
 	StatusCode int
+	Code       string
+	Message    string
+}
+
+type httpErrorDTO struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func newHttpError(statusCode int, body []byte) *HttpError {
+	e := &HttpError{StatusCode: statusCode}
+
+	dto := httpErrorDTO{}
+	if err := json.Unmarshal(body, &dto); err == nil && dto.Message != "" {
+		e.Code = dto.Code
+		e.Message = dto.Message
+		e.error = fmt.Errorf("%s: %s", dto.Code, dto.Message)
+	} else {
+		e.error = errors.New(string(body))
+	}
+
+	return e
+}
+
+func (e *HttpError) Is(target error) bool {
+	switch target {
+	case ErrResourceNotFound:
+		return e.StatusCode == http.StatusNotFound || e.Code == "ResourceNotFound"
+	case ErrArgumentNotValid:
+		return e.StatusCode == http.StatusConflict || e.Code == "InvalidArgument"
+	case ErrInvalidRequest:
+		return e.StatusCode == http.StatusBadRequest || e.Code == "InvalidContent"
+	}
+
+	return false
 }
 
 type FeeCalculationStrategy uint32
@@ -50,6 +90,49 @@ type Config struct {
 	GenerationHash        *Hash
 	NetworkType
 	FeeCalculationStrategy
+	Transport *TransportConfig
+	Timeouts  *Timeouts
+	Retry     *RetryPolicy
+	Websocket *WebsocketConfig
+	Identity  *NetworkIdentity
+}
+
+// guards UsedBaseUrl of every Config, it is switched by failover and verification of network identity
+// while other requests of Client are built
+var usedBaseUrlMutex sync.RWMutex
+
+// GetUsedBaseUrl returns url of node, which Client currently sends requests to
+// it should be used instead of reading UsedBaseUrl once Config is passed to NewClient
+func (c *Config) GetUsedBaseUrl() *url.URL {
+	usedBaseUrlMutex.RLock()
+	defer usedBaseUrlMutex.RUnlock()
+
+	return c.UsedBaseUrl
+}
+
+// SetUsedBaseUrl switches node, which Client sends requests to
+func (c *Config) SetUsedBaseUrl(u *url.URL) {
+	usedBaseUrlMutex.Lock()
+	defer usedBaseUrlMutex.Unlock()
+
+	c.UsedBaseUrl = u
+}
+
+// returns shallow copy of config, which is safe while UsedBaseUrl is switched
+func (c *Config) copy() Config {
+	usedBaseUrlMutex.RLock()
+	defer usedBaseUrlMutex.RUnlock()
+
+	return *c
+}
+
+// WebsocketConfig provides defaults of websocket client's ReconnectionOptions, zero values mean defaults of websocket package
+// initial backoff of reconnection is configured by Config's WsReconnectionTimeout
+type WebsocketConfig struct {
+	PingInterval time.Duration
+	StaleTimeout time.Duration
+	MaxBackoff   time.Duration
+	MaxAttempts  int
 }
 
 type reputationConfig struct {
@@ -72,11 +155,7 @@ func NewReputationConfig(minInter uint64, defaultRep float64) (*reputationConfig
 
 // returns config for HTTP Client from passed node url, filled by information from remote blockchain node
 func NewConfig(ctx context.Context, baseUrls []string) (*Config, error) {
-	// We want to fill config from remote node(get network type, generationHash of network and etc.).
-	// To fill config we need to create a Client. But to create a Client we need to create config =)
-	// So we create temporary config with information about connection to server, then create temporary client,
-	// which requests information and after that we create a final fully filled config
-	tempConf, err := NewConfigWithReputation(
+	conf, err := NewConfigWithReputation(
 		baseUrls,
 		NotSupportedNet,
 		&defaultRepConfig,
@@ -89,26 +168,37 @@ func NewConfig(ctx context.Context, baseUrls []string) (*Config, error) {
 		return nil, err
 	}
 
-	tempClient := NewClient(nil, tempConf)
-
-	block, err := tempClient.Blockchain.GetBlockByHeight(ctx, Height(1))
-	if err != nil {
+	if err := conf.requestNetworkIdentity(ctx); err != nil {
 		return nil, err
 	}
 
-	networkType, err := tempClient.Network.GetNetworkType(ctx)
-	if err != nil {
-		return nil, err
+	return conf, nil
+}
+
+// fills network type and generation hash, which are not set, by information from remote blockchain node
+// config is not complete yet, but it is enough for client, which requests this information
+func (c *Config) requestNetworkIdentity(ctx context.Context) error {
+	client := NewClient(nil, c)
+
+	if c.GenerationHash == nil {
+		block, err := client.Blockchain.GetBlockByHeight(ctx, Height(1))
+		if err != nil {
+			return err
+		}
+
+		c.GenerationHash = block.GenerationHash
 	}
 
-	return NewConfigWithReputation(
-		baseUrls,
-		networkType,
-		&defaultRepConfig,
-		DefaultWebsocketReconnectionTimeout,
-		block.GenerationHash,
-		DefaultFeeCalculationStrategy,
-	)
+	if c.NetworkType == NotSupportedNet {
+		networkType, err := client.Network.GetNetworkType(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.NetworkType = networkType
+	}
+
+	return nil
 }
 
 func NewConfigWithReputation(
@@ -119,7 +209,7 @@ func NewConfigWithReputation(
 	generationHash *Hash,
 	strategy FeeCalculationStrategy) (*Config, error) {
 	if len(baseUrls) == 0 {
-		return nil, errors.New("empty base urls")
+		return nil, ErrEmptyBaseUrls
 	}
 	urls := make([]*url.URL, 0, len(baseUrls))
 
@@ -160,6 +250,10 @@ type Client struct {
 	Account     *AccountService
 	Contract    *ContractService
 	Metadata    *MetadataService
+	Lock        *LockService
+
+	middlewares []RequestMiddleware
+	observer    Observer
 }
 
 type service struct {
@@ -167,8 +261,12 @@ type service struct {
 }
 
 // returns catapult http.Client from passed existing client and configuration
-// if passed client is nil, http.DefaultClient will be used
+// if passed client is nil, client built from config's Transport or http.DefaultClient will be used
 func NewClient(httpClient *http.Client, conf *Config) *Client {
+	if httpClient == nil && conf != nil && conf.Transport != nil {
+		httpClient = conf.Transport.NewHttpClient()
+	}
+
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
@@ -184,6 +282,7 @@ func NewClient(httpClient *http.Client, conf *Config) *Client {
 	c.Account = (*AccountService)(&c.common)
 	c.Contract = (*ContractService)(&c.common)
 	c.Metadata = (*MetadataService)(&c.common)
+	c.Lock = (*LockService)(&c.common)
 
 	return c
 }
@@ -197,6 +296,7 @@ func (c *Client) GenerationHash() *Hash {
 }
 
 // AdaptAccount returns a new account with the same network type and generation hash like a Client
+// it fails with ErrNetworkIdentityMismatch if they differ from pinned network identity of Config
 func (c *Client) AdaptAccount(account *Account) (*Account, error) {
 	return c.NewAccountFromPrivateKey(account.PrivateKey.String())
 }
@@ -208,29 +308,16 @@ func (c *Client) doNewRequest(ctx context.Context, method string, path string, b
 		return nil, err
 	}
 
+	ctx, cancel := c.withTimeout(ctx, method, path)
+	defer cancel()
+
 	resp, err := c.do(ctx, req, v)
 	if err != nil {
-		switch err.(type) {
-		case *url.Error:
-			for _, url := range c.config.BaseURLs {
-				if c.config.UsedBaseUrl == url {
-					continue
-				}
-
-				req.URL.Host = url.Host
-				resp, err = c.do(ctx, req, v)
-				if err != nil {
-					continue
-				}
-
-				c.config.UsedBaseUrl = url
-				return resp, nil
-			}
-
-			return nil, err
-		default:
-			return nil, err
+		if _, ok := err.(*url.Error); ok {
+			return c.failover(ctx, req, v, err)
 		}
+
+		return nil, err
 	}
 
 	return resp, nil
@@ -238,17 +325,55 @@ func (c *Client) doNewRequest(ctx context.Context, method string, path string, b
 
 // do sends an API Request and returns a parsed response
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	if c.observer != nil {
+		return c.observedDo(ctx, req, v)
+	}
+
+	return c.send(ctx, req, v, nil)
+}
+
+// sends request like do and reports it to client's Observer
+func (c *Client) observedDo(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	info := &RequestInfo{
+		Method: req.Method,
+		Route:  routeOf(req.URL.Path),
+		Host:   req.URL.Host,
+	}
+
+	if req.ContentLength > 0 {
+		info.BytesSent = req.ContentLength
+	}
+
+	start := time.Now()
+	resp, err := c.send(ctx, req, v, info)
+
+	info.Duration = time.Since(start)
+	info.Err = err
+
+	if e, ok := err.(*HttpError); ok {
+		info.StatusCode = e.StatusCode
+	} else if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	c.observer.ObserveRequest(info)
+
+	return resp, err
+}
+
+// sends request and parses response into v, number of received bytes is put into info if it is not nil
+func (c *Client) send(ctx context.Context, req *http.Request, v interface{}, info *RequestInfo) (*http.Response, error) {
 
 	// set the Context for this request
-	req.WithContext(ctx)
+	req = req.WithContext(ctx)
 
-	resp, err := c.client.Do(req)
+	resp, err := c.roundTrip(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, contextError(ctx)
 		default:
 		}
 		return nil, err
@@ -256,14 +381,19 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 
 	defer resp.Body.Close()
 
+	if info != nil {
+		body := &countingReader{ReadCloser: resp.Body}
+		resp.Body = body
+
+		defer func() {
+			info.BytesReceived = body.n
+		}()
+	}
+
 	if resp.StatusCode > 226 || resp.StatusCode < 200 {
 		b := &bytes.Buffer{}
 		b.ReadFrom(resp.Body)
-		httpError := HttpError{
-			errors.New(b.String()),
-			resp.StatusCode,
-		}
-		return nil, &httpError
+		return nil, newHttpError(resp.StatusCode, b.Bytes())
 	}
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
@@ -276,6 +406,10 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 			if decErr != nil {
 				err = decErr
 			}
+			if err != nil && ctx.Err() != nil {
+				// body is not read completely, because request is cancelled
+				err = contextError(ctx)
+			}
 		}
 	}
 
@@ -283,7 +417,7 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*htt
 }
 
 func (c *Client) newRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	u, err := c.config.UsedBaseUrl.Parse(urlStr)
+	u, err := c.config.GetUsedBaseUrl().Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -308,14 +442,26 @@ func (c *Client) newRequest(method, urlStr string, body interface{}) (*http.Requ
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	if c.config.Transport != nil {
+		c.config.Transport.addHeader(req.Header)
+	}
+
 	return req, nil
 }
 
 func (c *Client) NewAccount() (*Account, error) {
+	if err := c.checkConfigIdentity(); err != nil {
+		return nil, err
+	}
+
 	return NewAccount(c.config.NetworkType, c.config.GenerationHash)
 }
 
 func (c *Client) NewAccountFromPrivateKey(pKey string) (*Account, error) {
+	if err := c.checkConfigIdentity(); err != nil {
+		return nil, err
+	}
+
 	return NewAccountFromPrivateKey(pKey, c.config.NetworkType, c.config.GenerationHash)
 }
 
@@ -42,6 +42,10 @@ func Uint64(v uint64) *uint64 { return &v }
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
 
+// Float64 is a helper routine that allocates a new float64 value
+// to store v and returns a pointer to it.
+func Float64(v float64) *float64 { return &v }
+
 func TestBigIntegerToHex_bigIntegerNEMAndXEMToHex(t *testing.T) {
 	testHexConversion(t, 15358872602548358953, "D525AD41D95FCF29")
 	testHexConversion(t, 9562080086528621131, "84B3552D375FFA4B")
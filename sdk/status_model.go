@@ -0,0 +1,205 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"strings"
+)
+
+// StatusSeverity is the first part of validation result name
+type StatusSeverity string
+
+const (
+	SuccessSeverity StatusSeverity = "Success"
+	NeutralSeverity StatusSeverity = "Neutral"
+	FailureSeverity StatusSeverity = "Failure"
+)
+
+// StatusCategory is the facility of node, which produced validation result, it is the second part of validation result name
+type StatusCategory string
+
+const (
+	CoreStatusCategory              StatusCategory = "Core"
+	HashStatusCategory              StatusCategory = "Hash"
+	SignatureStatusCategory         StatusCategory = "Signature"
+	AggregateStatusCategory         StatusCategory = "Aggregate"
+	ChainStatusCategory             StatusCategory = "Chain"
+	ConsumerStatusCategory          StatusCategory = "Consumer"
+	MosaicStatusCategory            StatusCategory = "Mosaic"
+	NamespaceStatusCategory         StatusCategory = "Namespace"
+	MultisigStatusCategory          StatusCategory = "Multisig"
+	LockHashStatusCategory          StatusCategory = "LockHash"
+	LockSecretStatusCategory        StatusCategory = "LockSecret"
+	TransferStatusCategory          StatusCategory = "Transfer"
+	PropertyStatusCategory          StatusCategory = "Property"
+	MetadataStatusCategory          StatusCategory = "Metadata"
+	ContractStatusCategory          StatusCategory = "Contract"
+	BlockchainUpgradeStatusCategory StatusCategory = "BlockchainUpgrade"
+	NetworkConfigStatusCategory     StatusCategory = "NetworkConfig"
+)
+
+const successStatus = "Success"
+
+// ValidationResult describes result of transaction validation, which is reported by node in status of transaction,
+// e.g. Failure_Core_Insufficient_Balance
+// it implements error, results are equal for errors.Is if they have the same Status
+type ValidationResult struct {
+	Status      string
+	Severity    StatusSeverity
+	Category    StatusCategory
+	Description string
+}
+
+func (r *ValidationResult) Error() string {
+	return r.Status + ": " + r.Description
+}
+
+func (r *ValidationResult) Is(target error) bool {
+	t, ok := target.(*ValidationResult)
+	return ok && t.Status == r.Status
+}
+
+func (r *ValidationResult) IsSuccess() bool {
+	return r.Severity == SuccessSeverity
+}
+
+func (r *ValidationResult) IsFailure() bool {
+	return r.Severity == FailureSeverity
+}
+
+var validationResults = make(map[string]*ValidationResult)
+
+func newValidationResult(status, description string) *ValidationResult {
+	r := parseValidationResult(status)
+	r.Description = description
+	validationResults[status] = r
+
+	return r
+}
+
+// returns result parsed from status name, description is made of the last part of name
+func parseValidationResult(status string) *ValidationResult {
+	if status == successStatus {
+		return &ValidationResult{Status: status, Severity: SuccessSeverity, Description: "transaction is valid"}
+	}
+
+	parts := strings.SplitN(status, "_", 3)
+	r := &ValidationResult{Status: status, Severity: StatusSeverity(parts[0]), Description: status}
+
+	if len(parts) == 3 {
+		r.Category = StatusCategory(parts[1])
+		r.Description = strings.ToLower(strings.Replace(parts[2], "_", " ", -1))
+	}
+
+	return r
+}
+
+// returns ValidationResult of the catalog by passed status, unknown statuses are parsed from their names
+func ValidationResultOf(status string) *ValidationResult {
+	if r, ok := validationResults[status]; ok {
+		return r
+	}
+
+	return parseValidationResult(status)
+}
+
+// returns nil if transaction is valid, ValidationResult otherwise
+func (ts *TransactionStatus) Err() error {
+	return validationError(ts.Status)
+}
+
+// returns nil if transaction is valid, ValidationResult otherwise
+func (s *StatusInfo) Err() error {
+	return validationError(s.Status)
+}
+
+func validationError(status string) error {
+	r := ValidationResultOf(status)
+	if r.IsSuccess() {
+		return nil
+	}
+
+	return r
+}
+
+// Catalog of validation results
+var (
+	StatusSuccess = newValidationResult(successStatus, "transaction is valid")
+
+	ErrCorePastDeadline           = newValidationResult("Failure_Core_Past_Deadline", "deadline of transaction is in the past")
+	ErrCoreFutureDeadline         = newValidationResult("Failure_Core_Future_Deadline", "deadline of transaction is too far in the future")
+	ErrCoreInsufficientBalance    = newValidationResult("Failure_Core_Insufficient_Balance", "account has not enough balance")
+	ErrCoreTooManyTransactions    = newValidationResult("Failure_Core_Too_Many_Transactions", "block contains too many transactions")
+	ErrCoreWrongNetwork           = newValidationResult("Failure_Core_Wrong_Network", "entity is created for another network")
+	ErrCoreInvalidAddress         = newValidationResult("Failure_Core_Invalid_Address", "address is invalid")
+	ErrCoreInvalidVersion         = newValidationResult("Failure_Core_Invalid_Version", "version of entity is not supported")
+	ErrCoreInvalidTransactionFee  = newValidationResult("Failure_Core_Invalid_Transaction_Fee", "transaction fee is invalid")
+	ErrCoreZeroPublicKey          = newValidationResult("Failure_Core_Zero_Public_Key", "public key is zero")
+	ErrCoreInvalidLinkAction      = newValidationResult("Failure_Core_Invalid_Link_Action", "link action is invalid")
+	ErrCoreLinkAlreadyExists      = newValidationResult("Failure_Core_Link_Already_Exists", "account is already linked")
+	ErrCoreInconsistentUnlinkData = newValidationResult("Failure_Core_Inconsistent_Unlink_Data", "unlinked account doesn't match linked one")
+
+	ErrHashExists             = newValidationResult("Failure_Hash_Exists", "transaction with the same hash is already confirmed")
+	ErrSignatureNotVerifiable = newValidationResult("Failure_Signature_Not_Verifiable", "signature is not valid")
+
+	ErrAggregateTooManyTransactions   = newValidationResult("Failure_Aggregate_Too_Many_Transactions", "aggregate contains too many transactions")
+	ErrAggregateNoTransactions        = newValidationResult("Failure_Aggregate_No_Transactions", "aggregate has no inner transactions")
+	ErrAggregateTooManyCosignatures   = newValidationResult("Failure_Aggregate_Too_Many_Cosignatures", "aggregate has too many cosignatures")
+	ErrAggregateRedundantCosignatures = newValidationResult("Failure_Aggregate_Redundant_Cosignatures", "aggregate has redundant cosignatures")
+	ErrAggregateIneligibleCosigners   = newValidationResult("Failure_Aggregate_Ineligible_Cosigners", "aggregate is cosigned by ineligible accounts")
+	ErrAggregateMissingCosigners      = newValidationResult("Failure_Aggregate_Missing_Cosigners", "aggregate is not cosigned by all required accounts")
+
+	ErrChainUnlinked                = newValidationResult("Failure_Chain_Unlinked", "block is not linked to the chain")
+	ErrChainUnconfirmedCacheTooFull = newValidationResult("Failure_Chain_Unconfirmed_Cache_Too_Full", "unconfirmed transactions cache of node is full")
+
+	ErrMosaicInvalidDuration      = newValidationResult("Failure_Mosaic_Invalid_Duration", "mosaic duration is invalid")
+	ErrMosaicExpired              = newValidationResult("Failure_Mosaic_Expired", "mosaic is expired")
+	ErrMosaicOwnerConflict        = newValidationResult("Failure_Mosaic_Owner_Conflict", "mosaic is owned by another account")
+	ErrMosaicInvalidDivisibility  = newValidationResult("Failure_Mosaic_Invalid_Divisibility", "mosaic divisibility is invalid")
+	ErrMosaicSupplyImmutable      = newValidationResult("Failure_Mosaic_Supply_Immutable", "mosaic supply can't be changed")
+	ErrMosaicSupplyNegative       = newValidationResult("Failure_Mosaic_Supply_Negative", "mosaic supply would become negative")
+	ErrMosaicSupplyExceeded       = newValidationResult("Failure_Mosaic_Supply_Exceeded", "mosaic supply would exceed maximum")
+	ErrMosaicNonTransferable      = newValidationResult("Failure_Mosaic_Non_Transferable", "mosaic can be transferred only by its owner")
+	ErrMosaicMaxMosaicsExceeded   = newValidationResult("Failure_Mosaic_Max_Mosaics_Exceeded", "account owns too many mosaics")
+	ErrMosaicModificationNoChange = newValidationResult("Failure_Mosaic_Modification_No_Changes", "mosaic modification doesn't change anything")
+
+	ErrNamespaceInvalidDuration = newValidationResult("Failure_Namespace_Invalid_Duration", "namespace duration is invalid")
+	ErrNamespaceInvalidName     = newValidationResult("Failure_Namespace_Invalid_Name", "namespace name is invalid")
+	ErrNamespaceExpired         = newValidationResult("Failure_Namespace_Expired", "namespace is expired")
+	ErrNamespaceOwnerConflict   = newValidationResult("Failure_Namespace_Owner_Conflict", "namespace is owned by another account")
+	ErrNamespaceParentUnknown   = newValidationResult("Failure_Namespace_Parent_Unknown", "parent namespace is unknown")
+	ErrNamespaceAliasAlreadySet = newValidationResult("Failure_Namespace_Alias_Already_Exists", "namespace already has alias")
+	ErrNamespaceUnknownAlias    = newValidationResult("Failure_Namespace_Unknown_Alias", "namespace has no alias")
+
+	ErrMultisigNotACosigner                = newValidationResult("Failure_Multisig_Modify_Not_A_Cosigner", "account is not a cosigner of multisig")
+	ErrMultisigAlreadyACosigner            = newValidationResult("Failure_Multisig_Modify_Already_A_Cosigner", "account is already a cosigner of multisig")
+	ErrMultisigMinSettingOutOfRange        = newValidationResult("Failure_Multisig_Modify_Min_Setting_Out_Of_Range", "min approval or removal is out of range")
+	ErrMultisigMinSettingLargerThanCosigns = newValidationResult("Failure_Multisig_Modify_Min_Setting_Larger_Than_Num_Cosignatories", "min approval or removal is larger than number of cosigners")
+	ErrMultisigMaxCosigners                = newValidationResult("Failure_Multisig_Modify_Max_Cosigners", "multisig has too many cosigners")
+	ErrMultisigLoop                        = newValidationResult("Failure_Multisig_Modify_Loop", "modification creates loop of multisig accounts")
+	ErrMultisigMaxDepth                    = newValidationResult("Failure_Multisig_Modify_Max_Multisig_Depth", "multisig graph is too deep")
+	ErrMultisigOperationNotPermitted       = newValidationResult("Failure_Multisig_Operation_Not_Permitted_By_Account", "multisig account can't initiate transactions")
+
+	ErrLockHashInvalidMosaicId     = newValidationResult("Failure_LockHash_Invalid_Mosaic_Id", "hash lock mosaic is not the currency mosaic")
+	ErrLockHashInvalidMosaicAmount = newValidationResult("Failure_LockHash_Invalid_Mosaic_Amount", "hash lock amount is invalid")
+	ErrLockHashExists              = newValidationResult("Failure_LockHash_Hash_Exists", "hash lock already exists")
+	ErrLockHashUnknownHash         = newValidationResult("Failure_LockHash_Unknown_Hash", "aggregate bonded is not locked")
+	ErrLockHashInactiveHash        = newValidationResult("Failure_LockHash_Inactive_Hash", "hash lock is inactive")
+	ErrLockHashInvalidDuration     = newValidationResult("Failure_LockHash_Invalid_Duration", "hash lock duration is invalid")
+
+	ErrLockSecretInvalidHashAlgorithm = newValidationResult("Failure_LockSecret_Invalid_Hash_Algorithm", "hash algorithm of secret is invalid")
+	ErrLockSecretHashExists           = newValidationResult("Failure_LockSecret_Hash_Exists", "secret lock already exists")
+	ErrLockSecretSecretMismatch       = newValidationResult("Failure_LockSecret_Secret_Mismatch", "proof doesn't match secret")
+	ErrLockSecretUnknownCompositeKey  = newValidationResult("Failure_LockSecret_Unknown_Composite_Key", "secret lock is unknown")
+	ErrLockSecretInactiveSecret       = newValidationResult("Failure_LockSecret_Inactive_Secret", "secret lock is inactive")
+	ErrLockSecretInvalidDuration      = newValidationResult("Failure_LockSecret_Invalid_Duration", "secret lock duration is invalid")
+
+	ErrTransferMessageTooLarge    = newValidationResult("Failure_Transfer_Message_Too_Large", "transfer message is too large")
+	ErrTransferOutOfOrderMosaics  = newValidationResult("Failure_Transfer_Out_Of_Order_Mosaics", "mosaics of transfer are not sorted")
+	ErrPropertyAddressInteraction = newValidationResult("Failure_Property_Signer_Address_Interaction_Not_Allowed", "account properties don't allow interaction with address")
+	ErrPropertyMosaicTransfer     = newValidationResult("Failure_Property_Mosaic_Transfer_Not_Allowed", "account properties don't allow transfer of mosaic")
+	ErrPropertyTransactionType    = newValidationResult("Failure_Property_Transaction_Type_Not_Allowed", "account properties don't allow transaction type")
+	StatusHashInRecencyCache      = newValidationResult("Neutral_Consumer_Hash_In_Recency_Cache", "transaction is already received by node")
+)
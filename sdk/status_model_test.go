@@ -0,0 +1,61 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationResultOf(t *testing.T) {
+	r := ValidationResultOf("Failure_Core_Insufficient_Balance")
+	assert.Equal(t, ErrCoreInsufficientBalance, r)
+	assert.Equal(t, FailureSeverity, r.Severity)
+	assert.Equal(t, CoreStatusCategory, r.Category)
+	assert.True(t, r.IsFailure())
+
+	unknown := ValidationResultOf("Failure_Storage_Drive_Not_Found")
+	assert.Equal(t, &ValidationResult{
+		Status:      "Failure_Storage_Drive_Not_Found",
+		Severity:    FailureSeverity,
+		Category:    "Storage",
+		Description: "drive not found",
+	}, unknown)
+
+	assert.True(t, ValidationResultOf("Success").IsSuccess())
+}
+
+func TestTransactionStatus_Err(t *testing.T) {
+	assert.Nil(t, (&TransactionStatus{Status: "Success"}).Err())
+
+	err := (&TransactionStatus{Status: "Failure_LockHash_Unknown_Hash"}).Err()
+	assert.True(t, errors.Is(err, ErrLockHashUnknownHash))
+	assert.False(t, errors.Is(err, ErrLockHashInactiveHash))
+
+	var result *ValidationResult
+	assert.True(t, errors.As((&StatusInfo{Status: "Failure_Mosaic_Expired"}).Err(), &result))
+	assert.Equal(t, MosaicStatusCategory, result.Category)
+	assert.Equal(t, "Failure_Mosaic_Expired: mosaic is expired", result.Error())
+}
+
+func TestNewHttpError(t *testing.T) {
+	err := newHttpError(404, []byte(`{"code":"ResourceNotFound","message":"no resource exists with id 'ABC'"}`))
+	assert.Equal(t, "ResourceNotFound", err.Code)
+	assert.Equal(t, "no resource exists with id 'ABC'", err.Message)
+	assert.Equal(t, "ResourceNotFound: no resource exists with id 'ABC'", err.Error())
+	assert.True(t, errors.Is(err, ErrResourceNotFound))
+	assert.False(t, errors.Is(err, ErrArgumentNotValid))
+
+	err = newHttpError(409, []byte("conflict"))
+	assert.Equal(t, "", err.Code)
+	assert.Equal(t, "conflict", err.Error())
+	assert.True(t, errors.Is(err, ErrArgumentNotValid))
+
+	var httpError *HttpError
+	assert.True(t, errors.As(error(err), &httpError))
+	assert.Equal(t, 409, httpError.StatusCode)
+}
@@ -0,0 +1,20 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package swap
+
+import "errors"
+
+var (
+	ErrNilConfig             = errors.New("swap config should not be nil")
+	ErrNilChain              = errors.New("swap chain should have client and account")
+	ErrNilCounterparty       = errors.New("counterparty accounts should not be nil")
+	ErrNilMosaic             = errors.New("swapped mosaics should not be nil")
+	ErrNilSecret             = errors.New("secret should not be nil")
+	ErrProofNotRevealed      = errors.New("proof is not revealed yet")
+	ErrCounterpartyNotLocked = errors.New("counterparty lock is not found yet")
+	ErrAlreadyLocked         = errors.New("mosaic is already locked by this swap")
+	ErrUnsafeLockDuration    = errors.New("lock of participant should expire before the half of remaining time of initiator lock")
+	ErrSwapTimeout           = errors.New("swap is timed out, locked mosaic is returned to the owner after lock expiration")
+)
@@ -0,0 +1,577 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// Package swap implements atomic cross-chain swap of mosaics between two Sirius/Catapult networks
+// with SecretLockTransaction's and SecretProofTransaction's.
+//
+// Initiator generates random proof and locks mosaic for participant on his chain for a long time.
+// Participant finds initiator lock and locks mosaic for initiator with the same secret on the other chain
+// for at most the half of remaining time of initiator lock. Initiator redeems participant lock with the proof,
+// so the proof is revealed and participant redeems initiator lock with it.
+// If any party doesn't continue the swap, locked mosaics are returned to the owners after lock expiration.
+package swap
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+const (
+	DefaultLockDuration = 24 * time.Hour
+	DefaultRedeemWindow = time.Hour
+	DefaultPollInterval = 15 * time.Second
+	DefaultDeadline     = time.Hour
+	ProofSize           = 32
+)
+
+// Chain is one side of the swap: network and account of the party in it
+// `BlockGenerationTargetTime` is used to convert durations to blocks, it is requested from network config if zero
+type Chain struct {
+	Client                    *sdk.Client
+	Account                   *sdk.Account
+	BlockGenerationTargetTime time.Duration
+}
+
+// Config describes swap from the point of view of one party
+// `HashType` - hash function of the secret, participant takes it from the secret of initiator
+// `Local` - chain, where party locks `Give` mosaic for `CounterpartyLocal`
+// `Remote` - chain, where `CounterpartyRemote` locks `Receive` mosaic for party
+// `LockDuration` - duration of lock of the party, initiator uses DefaultLockDuration if it is zero,
+// participant uses the half of remaining time of initiator lock if it is zero
+// `RedeemWindow` - minimal remaining time of counterparty lock, which is accepted to redeem it safely
+// `PollInterval` - interval of REST requests, which are used to watch counterparty transactions
+type Config struct {
+	HashType           sdk.HashType
+	Local              *Chain
+	Remote             *Chain
+	Give               *sdk.Mosaic
+	Receive            *sdk.Mosaic
+	CounterpartyLocal  *sdk.PublicAccount
+	CounterpartyRemote *sdk.PublicAccount
+	LockDuration       time.Duration
+	RedeemWindow       time.Duration
+	PollInterval       time.Duration
+}
+
+// Swap keeps state of atomic swap of one party
+// counterparty transactions are watched by REST polling, they can be passed from websocket with ConfirmedAddedHandler
+type Swap struct {
+	conf      Config
+	initiator bool
+	secret    *sdk.Secret
+	notify    chan struct{}
+
+	mu                     sync.Mutex
+	proof                  *sdk.Proof
+	lock                   *sdk.SignedTransaction
+	lockExpiresAt          time.Time
+	counterpartyLock       *sdk.SecretLockTransaction
+	counterpartyLockHeight sdk.Height
+}
+
+// returns Swap of initiator with random proof
+func NewInitiator(conf *Config) (*Swap, error) {
+	s, err := newSwap(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, ProofSize)
+	if _, err := rand.Read(data); err != nil {
+		return nil, err
+	}
+
+	s.proof = sdk.NewProofFromBytes(data)
+
+	s.secret, err = s.proof.Secret(s.conf.HashType)
+	if err != nil {
+		return nil, err
+	}
+
+	s.initiator = true
+
+	if s.conf.LockDuration == 0 {
+		s.conf.LockDuration = DefaultLockDuration
+	}
+
+	return s, nil
+}
+
+// returns Swap of participant for passed secret of initiator
+func NewParticipant(conf *Config, secret *sdk.Secret) (*Swap, error) {
+	if secret == nil {
+		return nil, ErrNilSecret
+	}
+
+	s, err := newSwap(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	s.secret = secret
+	s.conf.HashType = secret.Type
+
+	return s, nil
+}
+
+func newSwap(conf *Config) (*Swap, error) {
+	if conf == nil {
+		return nil, ErrNilConfig
+	}
+
+	if !conf.Local.valid() || !conf.Remote.valid() {
+		return nil, ErrNilChain
+	}
+
+	if conf.CounterpartyLocal == nil || conf.CounterpartyRemote == nil {
+		return nil, ErrNilCounterparty
+	}
+
+	if conf.Give == nil || conf.Give.AssetId == nil || conf.Receive == nil || conf.Receive.AssetId == nil {
+		return nil, ErrNilMosaic
+	}
+
+	c := *conf
+	local, remote := *conf.Local, *conf.Remote
+	c.Local, c.Remote = &local, &remote
+
+	if c.RedeemWindow == 0 {
+		c.RedeemWindow = DefaultRedeemWindow
+	}
+
+	if c.PollInterval == 0 {
+		c.PollInterval = DefaultPollInterval
+	}
+
+	return &Swap{
+		conf:   c,
+		notify: make(chan struct{}, 1),
+	}, nil
+}
+
+// returns true if party is initiator of the swap
+func (s *Swap) IsInitiator() bool {
+	return s.initiator
+}
+
+// returns Secret of the swap, initiator should pass it to participant
+func (s *Swap) Secret() *sdk.Secret {
+	return s.secret
+}
+
+// returns Proof of the swap, participant knows it only after initiator redeemed participant lock
+func (s *Swap) Proof() (*sdk.Proof, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.proof == nil {
+		return nil, ErrProofNotRevealed
+	}
+
+	return s.proof, nil
+}
+
+// returns counterparty SecretLockTransaction if it is already found
+func (s *Swap) CounterpartyLock() (*sdk.SecretLockTransaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.counterpartyLock == nil {
+		return nil, ErrCounterpartyNotLocked
+	}
+
+	return s.counterpartyLock, nil
+}
+
+// runs the whole swap for the party
+// initiator locks mosaic, waits for participant lock and redeems it
+// participant waits for initiator lock, locks mosaic, waits for revealed proof and redeems initiator lock
+func (s *Swap) Run(ctx context.Context) error {
+	if s.initiator {
+		if _, err := s.Lock(ctx); err != nil {
+			return err
+		}
+
+		if _, err := s.WaitCounterpartyLock(ctx); err != nil {
+			return err
+		}
+
+		_, err := s.Redeem(ctx)
+		return err
+	}
+
+	if _, err := s.WaitCounterpartyLock(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.Lock(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.WaitProof(ctx); err != nil {
+		return err
+	}
+
+	_, err := s.Redeem(ctx)
+	return err
+}
+
+// announces SecretLockTransaction of `Give` mosaic for counterparty on local chain
+// participant can lock only after initiator lock is found, duration of participant lock is checked against it
+func (s *Swap) Lock(ctx context.Context) (*sdk.SignedTransaction, error) {
+	s.mu.Lock()
+	locked := s.lock != nil
+	s.mu.Unlock()
+
+	if locked {
+		return nil, ErrAlreadyLocked
+	}
+
+	duration, err := s.lockDuration(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime, err := s.blockTime(ctx, s.conf.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.conf.Local.Client.NewSecretLockTransaction(
+		sdk.NewDeadline(DefaultDeadline),
+		s.conf.Give,
+		durationToBlocks(duration, blockTime),
+		s.secret,
+		s.conf.CounterpartyLocal.Address,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stx, err := s.conf.Local.Account.Sign(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.conf.Local.Client.Transaction.Announce(ctx, stx); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.lock = stx
+	s.lockExpiresAt = time.Now().Add(duration)
+	s.mu.Unlock()
+
+	return stx, nil
+}
+
+// waits for confirmed counterparty SecretLockTransaction with the secret of the swap on remote chain
+// returns ErrUnsafeLockDuration if remaining time of found lock is less than `RedeemWindow`
+// returns ErrSwapTimeout if own lock expired before counterparty locked mosaic
+func (s *Swap) WaitCounterpartyLock(ctx context.Context) (*sdk.SecretLockTransaction, error) {
+	err := s.wait(ctx, func(ctx context.Context) (bool, error) {
+		if _, err := s.CounterpartyLock(); err == nil {
+			return true, nil
+		}
+
+		txs, err := s.conf.Remote.Client.Account.OutgoingTransactions(ctx, s.conf.CounterpartyRemote, nil)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tx := range txs {
+			s.observe(tx)
+		}
+
+		_, err = s.CounterpartyLock()
+		return err == nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err := s.counterpartyLockRemaining(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if remaining < s.conf.RedeemWindow {
+		return nil, ErrUnsafeLockDuration
+	}
+
+	return s.CounterpartyLock()
+}
+
+// waits until counterparty redeems own lock on local chain and reveals the proof
+// returns ErrSwapTimeout if own lock expired before it was redeemed
+func (s *Swap) WaitProof(ctx context.Context) (*sdk.Proof, error) {
+	err := s.wait(ctx, func(ctx context.Context) (bool, error) {
+		if _, err := s.Proof(); err == nil {
+			return true, nil
+		}
+
+		txs, err := s.conf.Local.Client.Account.OutgoingTransactions(ctx, s.conf.CounterpartyLocal, nil)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tx := range txs {
+			s.observe(tx)
+		}
+
+		_, err = s.Proof()
+		return err == nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Proof()
+}
+
+// announces SecretProofTransaction, which redeems counterparty lock on remote chain
+// initiator reveals the proof by this transaction, so it should be called only after counterparty lock is found
+func (s *Swap) Redeem(ctx context.Context) (*sdk.SignedTransaction, error) {
+	proof, err := s.Proof()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.CounterpartyLock(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.conf.Remote.Client.NewSecretProofTransaction(
+		sdk.NewDeadline(DefaultDeadline),
+		s.secret.Type,
+		proof,
+		s.conf.Remote.Account.Address,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stx, err := s.conf.Remote.Account.Sign(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.conf.Remote.Client.Transaction.Announce(ctx, stx); err != nil {
+		return nil, err
+	}
+
+	return stx, nil
+}
+
+// returns handler, which can be added to websocket client for counterparty addresses on both chains,
+// so counterparty transactions are noticed without waiting for the next poll
+// handler is removed after counterparty lock is found and the proof is known
+func (s *Swap) ConfirmedAddedHandler() func(sdk.Transaction) bool {
+	return func(tx sdk.Transaction) bool {
+		s.observe(tx)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return s.proof != nil && s.counterpartyLock != nil
+	}
+}
+
+func (s *Swap) observe(tx sdk.Transaction) {
+	height := sdk.Height(0)
+	if info := tx.GetAbstractTransaction().TransactionInfo; info != nil {
+		height = info.Height
+	}
+
+	s.observeAt(tx, height)
+}
+
+func (s *Swap) observeAt(tx sdk.Transaction, height sdk.Height) {
+	switch t := tx.(type) {
+	case *sdk.AggregateTransaction:
+		for _, inner := range t.InnerTransactions {
+			s.observeAt(inner, height)
+		}
+	case *sdk.SecretLockTransaction:
+		if height == 0 || !s.isCounterpartyLock(t) {
+			return
+		}
+
+		s.mu.Lock()
+		if s.counterpartyLock == nil {
+			s.counterpartyLock = t
+			s.counterpartyLockHeight = height
+		}
+		s.mu.Unlock()
+
+		s.wakeUp()
+	case *sdk.SecretProofTransaction:
+		if t.Proof == nil {
+			return
+		}
+
+		secret, err := t.Proof.Secret(t.HashType)
+		if err != nil || secret.Hash != s.secret.Hash {
+			return
+		}
+
+		s.mu.Lock()
+		if s.proof == nil {
+			s.proof = t.Proof
+		}
+		s.mu.Unlock()
+
+		s.wakeUp()
+	}
+}
+
+func (s *Swap) isCounterpartyLock(tx *sdk.SecretLockTransaction) bool {
+	if tx.Secret == nil || tx.Secret.Type != s.secret.Type || tx.Secret.Hash != s.secret.Hash {
+		return false
+	}
+
+	if tx.Recipient == nil || tx.Recipient.Address != s.conf.Remote.Account.Address.Address {
+		return false
+	}
+
+	if tx.Signer != nil && !strings.EqualFold(tx.Signer.PublicKey, s.conf.CounterpartyRemote.PublicKey) {
+		return false
+	}
+
+	return tx.Mosaic != nil && tx.Mosaic.AssetId != nil &&
+		tx.Mosaic.AssetId.Id() == s.conf.Receive.AssetId.Id() &&
+		tx.Mosaic.Amount >= s.conf.Receive.Amount
+}
+
+func (s *Swap) wakeUp() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// calls check every poll interval or when counterparty transaction is observed until it returns true
+func (s *Swap) wait(ctx context.Context, check func(context.Context) (bool, error)) error {
+	ticker := time.NewTicker(s.conf.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		if s.timedOut() {
+			return ErrSwapTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-s.notify:
+		}
+	}
+}
+
+func (s *Swap) timedOut() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lock != nil && !time.Now().Before(s.lockExpiresAt)
+}
+
+func (s *Swap) lockDuration(ctx context.Context) (time.Duration, error) {
+	if s.initiator {
+		return s.conf.LockDuration, nil
+	}
+
+	remaining, err := s.counterpartyLockRemaining(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	safe := remaining / 2
+
+	if s.conf.LockDuration == 0 {
+		return safe, nil
+	}
+
+	if s.conf.LockDuration > safe {
+		return 0, ErrUnsafeLockDuration
+	}
+
+	return s.conf.LockDuration, nil
+}
+
+func (s *Swap) counterpartyLockRemaining(ctx context.Context) (time.Duration, error) {
+	s.mu.Lock()
+	lock, lockHeight := s.counterpartyLock, s.counterpartyLockHeight
+	s.mu.Unlock()
+
+	if lock == nil {
+		return 0, ErrCounterpartyNotLocked
+	}
+
+	blockTime, err := s.blockTime(ctx, s.conf.Remote)
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := s.conf.Remote.Client.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	endHeight := uint64(lockHeight) + uint64(lock.Duration)
+	if endHeight <= uint64(height) {
+		return 0, nil
+	}
+
+	return time.Duration(endHeight-uint64(height)) * blockTime, nil
+}
+
+func (s *Swap) blockTime(ctx context.Context, chain *Chain) (time.Duration, error) {
+	s.mu.Lock()
+	blockTime := chain.BlockGenerationTargetTime
+	s.mu.Unlock()
+
+	if blockTime != 0 {
+		return blockTime, nil
+	}
+
+	conf, err := chain.Client.Network.GetNetworkConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	blockTime, err = conf.NetworkConfig.BlockGenerationTargetTime()
+	if err != nil {
+		blockTime = sdk.DefaultBlockGenerationTargetTime
+	}
+
+	s.mu.Lock()
+	chain.BlockGenerationTargetTime = blockTime
+	s.mu.Unlock()
+
+	return blockTime, nil
+}
+
+func (c *Chain) valid() bool {
+	return c != nil && c.Client != nil && c.Account != nil
+}
+
+// returns number of blocks, which are generated during passed duration, rounded up
+func durationToBlocks(duration, blockTime time.Duration) sdk.Duration {
+	return sdk.Duration((duration + blockTime - 1) / blockTime)
+}
@@ -0,0 +1,207 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package swap
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/proximax-storage/go-xpx-utils/mock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+const announceRespBody = `{"message": "packet 9 was pushed to the network via /transaction"}`
+
+type testParty struct {
+	local  *sdk.Account
+	remote *sdk.Account
+}
+
+func newTestParty(t *testing.T) *testParty {
+	local, err := sdk.NewAccount(sdk.PublicTest, &sdk.Hash{1})
+	assert.Nil(t, err)
+
+	remote, err := sdk.NewAccount(sdk.PublicTest, &sdk.Hash{2})
+	assert.Nil(t, err)
+
+	return &testParty{local, remote}
+}
+
+func newTestChain(t *testing.T, server *mock.Mock, account *sdk.Account) *Chain {
+	repConf, err := sdk.NewReputationConfig(0, 0.9)
+	assert.Nil(t, err)
+
+	conf, err := sdk.NewConfigWithReputation(
+		[]string{server.GetServerURL()},
+		sdk.PublicTest,
+		repConf,
+		sdk.DefaultWebsocketReconnectionTimeout,
+		&sdk.Hash{1},
+		sdk.DefaultFeeCalculationStrategy,
+	)
+	assert.Nil(t, err)
+
+	return &Chain{
+		Client:                    sdk.NewClient(nil, conf),
+		Account:                   account,
+		BlockGenerationTargetTime: 15 * time.Second,
+	}
+}
+
+func TestNewInitiator(t *testing.T) {
+	alice, bob := newTestParty(t), newTestParty(t)
+	server := mock.NewMock(0)
+	defer server.Close()
+
+	conf := &Config{
+		HashType:           sdk.SHA_256,
+		Local:              newTestChain(t, server, alice.local),
+		Remote:             newTestChain(t, server, alice.remote),
+		Give:               sdk.Xpx(10),
+		Receive:            sdk.Xpx(20),
+		CounterpartyLocal:  bob.local.PublicAccount,
+		CounterpartyRemote: bob.remote.PublicAccount,
+	}
+
+	s, err := NewInitiator(conf)
+	assert.Nil(t, err)
+	assert.True(t, s.IsInitiator())
+
+	proof, err := s.Proof()
+	assert.Nil(t, err)
+	assert.Equal(t, ProofSize, proof.Size())
+
+	secret, err := proof.Secret(sdk.SHA_256)
+	assert.Nil(t, err)
+	assert.Equal(t, secret, s.Secret())
+
+	_, err = s.Redeem(context.Background())
+	assert.Equal(t, ErrCounterpartyNotLocked, err)
+
+	_, err = NewInitiator(&Config{Local: conf.Local, Remote: conf.Remote})
+	assert.Equal(t, ErrNilCounterparty, err)
+
+	_, err = NewParticipant(conf, nil)
+	assert.Equal(t, ErrNilSecret, err)
+}
+
+func TestSwap_Participant(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := newTestParty(t), newTestParty(t)
+
+	proof := sdk.NewProofFromString("swap proof")
+	secret, err := proof.Secret(sdk.SHA3_256)
+	assert.Nil(t, err)
+
+	// bob receives on alice chain and locks on his own one
+	aliceChain := mock.NewMock(0)
+	defer aliceChain.Close()
+	aliceChain.AddRouter(&mock.Router{Path: "/chain/height", RespBody: `{"height": [100, 0]}`})
+	aliceChain.AddRouter(&mock.Router{Path: fmt.Sprintf("/account/%s/transactions/outgoing", alice.local.PublicAccount.PublicKey), RespBody: "[]"})
+	aliceChain.AddRouter(&mock.Router{Path: "/transaction", RespBody: announceRespBody})
+
+	bobChain := mock.NewMock(0)
+	defer bobChain.Close()
+	bobChain.AddRouter(&mock.Router{Path: fmt.Sprintf("/account/%s/transactions/outgoing", alice.remote.PublicAccount.PublicKey), RespBody: "[]"})
+	bobChain.AddRouter(&mock.Router{Path: "/transaction", RespBody: announceRespBody})
+
+	s, err := NewParticipant(&Config{
+		Local:              newTestChain(t, bobChain, bob.remote),
+		Remote:             newTestChain(t, aliceChain, bob.local),
+		Give:               sdk.Xpx(20),
+		Receive:            sdk.Xpx(10),
+		CounterpartyLocal:  alice.remote.PublicAccount,
+		CounterpartyRemote: alice.local.PublicAccount,
+		RedeemWindow:       10 * time.Minute,
+		PollInterval:       10 * time.Millisecond,
+	}, secret)
+	assert.Nil(t, err)
+
+	handler := s.ConfirmedAddedHandler()
+
+	_, err = s.Lock(ctx)
+	assert.Equal(t, ErrCounterpartyNotLocked, err)
+
+	// lock with too small amount is ignored
+	lock, err := sdk.NewSecretLockTransaction(sdk.NewDeadline(time.Hour), sdk.Xpx(5), 100, secret, bob.local.Address, sdk.PublicTest)
+	assert.Nil(t, err)
+	lock.Signer = alice.local.PublicAccount
+	lock.TransactionInfo = &sdk.TransactionInfo{Height: 90}
+	assert.False(t, handler(lock))
+
+	_, err = s.CounterpartyLock()
+	assert.Equal(t, ErrCounterpartyNotLocked, err)
+
+	lock.Mosaic = sdk.Xpx(10)
+	assert.False(t, handler(lock))
+
+	found, err := s.WaitCounterpartyLock(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, lock, found)
+
+	// 90 blocks of initiator lock remain, so participant locks for 45 blocks
+	stx, err := s.Lock(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.SecretLock, stx.EntityType)
+
+	_, err = s.Lock(ctx)
+	assert.Equal(t, ErrAlreadyLocked, err)
+
+	_, err = s.Redeem(ctx)
+	assert.Equal(t, ErrProofNotRevealed, err)
+
+	proofTx, err := sdk.NewSecretProofTransaction(sdk.NewDeadline(time.Hour), sdk.SHA3_256, proof, bob.remote.Address, sdk.PublicTest)
+	assert.Nil(t, err)
+	proofTx.TransactionInfo = &sdk.TransactionInfo{Height: 95}
+	assert.True(t, handler(proofTx))
+
+	revealed, err := s.WaitProof(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, proof, revealed)
+
+	stx, err = s.Redeem(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.SecretProof, stx.EntityType)
+}
+
+func TestSwap_UnsafeCounterpartyLock(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := newTestParty(t), newTestParty(t)
+
+	secret, err := sdk.NewProofFromString("swap proof").Secret(sdk.SHA3_256)
+	assert.Nil(t, err)
+
+	aliceChain := mock.NewMock(0)
+	defer aliceChain.Close()
+	aliceChain.AddRouter(&mock.Router{Path: "/chain/height", RespBody: `{"height": [100, 0]}`})
+
+	s, err := NewParticipant(&Config{
+		Local:              newTestChain(t, aliceChain, bob.remote),
+		Remote:             newTestChain(t, aliceChain, bob.local),
+		Give:               sdk.Xpx(20),
+		Receive:            sdk.Xpx(10),
+		CounterpartyLocal:  alice.remote.PublicAccount,
+		CounterpartyRemote: alice.local.PublicAccount,
+		LockDuration:       time.Hour,
+	}, secret)
+	assert.Nil(t, err)
+
+	lock, err := sdk.NewSecretLockTransaction(sdk.NewDeadline(time.Hour), sdk.Xpx(10), 100, secret, bob.local.Address, sdk.PublicTest)
+	assert.Nil(t, err)
+	lock.TransactionInfo = &sdk.TransactionInfo{Height: 90}
+	s.ConfirmedAddedHandler()(lock)
+
+	// 90 blocks are less than default redeem window
+	_, err = s.WaitCounterpartyLock(ctx)
+	assert.Equal(t, ErrUnsafeLockDuration, err)
+
+	// configured lock duration is longer than the half of initiator lock
+	_, err = s.Lock(ctx)
+	assert.Equal(t, ErrUnsafeLockDuration, err)
+}
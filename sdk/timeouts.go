@@ -0,0 +1,119 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RouteTimeouts limits duration of REST calls by class of route, zero means no limit
+// `Announce` is used for announcing of transactions, `Read` is used for the rest of calls
+type RouteTimeouts struct {
+	Read     time.Duration
+	Announce time.Duration
+}
+
+// Timeouts configures deadlines of REST calls, which are applied when context passed to service method has no deadline
+// `Services` overrides defaults for routes of services, they are keyed by names of Client's fields, e.g. "Account"
+// deadline covers retries on other nodes, ErrRequestTimeout is returned when it is exceeded
+type Timeouts struct {
+	RouteTimeouts
+	Services map[string]RouteTimeouts
+}
+
+// returns timeout of call with passed method and path, zero if it is not limited
+func (t *Timeouts) timeout(method, path string) time.Duration {
+	timeouts := t.RouteTimeouts
+
+	if s, ok := t.Services[serviceOf(path)]; ok {
+		if s.Read != 0 {
+			timeouts.Read = s.Read
+		}
+
+		if s.Announce != 0 {
+			timeouts.Announce = s.Announce
+		}
+	}
+
+	if method == http.MethodPut {
+		return timeouts.Announce
+	}
+
+	return timeouts.Read
+}
+
+// services of routes, which are nested in routes of other services
+var nestedServices = map[string]string{
+	"lock":       "Lock",
+	"contracts":  "Contract",
+	"metadata":   "Metadata",
+	"namespaces": "Namespace",
+}
+
+// services by the first segment of route
+var rootServices = map[string]string{
+	"account":      "Account",
+	"transactions": "Account",
+	"block":        "Blockchain",
+	"blocks":       "Blockchain",
+	"chain":        "Blockchain",
+	"diagnostic":   "Blockchain",
+	"contract":     "Contract",
+	"lock":         "Lock",
+	"metadata":     "Metadata",
+	"mosaic":       "Mosaic",
+	"namespace":    "Namespace",
+	"network":      "Network",
+	"config":       "Network",
+	"upgrade":      "Network",
+	"transaction":  "Transaction",
+}
+
+// returns name of Client's service field, which calls route with passed path
+func serviceOf(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, segment := range segments[1:] {
+		if service, ok := nestedServices[segment]; ok {
+			return service
+		}
+	}
+
+	return rootServices[segments[0]]
+}
+
+// returns context limited by configured timeout of call, if passed context has no deadline
+func (c *Client) withTimeout(ctx context.Context, method, path string) (context.Context, context.CancelFunc) {
+	if c.config == nil || c.config.Timeouts == nil {
+		return ctx, func() {}
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+
+	timeout := c.config.Timeouts.timeout(method, path)
+	if timeout == 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// returns ErrRequestTimeout or ErrRequestCanceled depending on the reason why passed context is done
+func contextError(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrRequestTimeout
+	}
+
+	return ErrRequestCanceled
+}
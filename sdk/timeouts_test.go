@@ -0,0 +1,91 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSlowServerClient(t *testing.T, timeouts *Timeouts) (*Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+
+	conf, err := NewConfigWithReputation([]string{server.URL}, PublicTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, nil, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	conf.Timeouts = timeouts
+
+	return NewClient(nil, conf), server.Close
+}
+
+func TestClient_Timeouts(t *testing.T) {
+	client, closeFn := newSlowServerClient(t, &Timeouts{
+		RouteTimeouts: RouteTimeouts{Read: time.Minute},
+		Services:      map[string]RouteTimeouts{"Blockchain": {Read: 50 * time.Millisecond}},
+	})
+	defer closeFn()
+
+	start := time.Now()
+	_, err := client.Blockchain.GetBlockchainHeight(context.Background())
+	assert.Equal(t, ErrRequestTimeout, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// deadline of passed context has priority
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start = time.Now()
+	_, err = client.Network.GetNetworkType(ctx)
+	assert.Equal(t, ErrRequestTimeout, err)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestClient_Cancel(t *testing.T) {
+	client, closeFn := newSlowServerClient(t, nil)
+	defer closeFn()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := client.Blockchain.GetBlockchainHeight(ctx)
+	assert.Equal(t, ErrRequestCanceled, err)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestTimeouts_timeout(t *testing.T) {
+	timeouts := &Timeouts{
+		RouteTimeouts: RouteTimeouts{Read: time.Second, Announce: 2 * time.Second},
+		Services: map[string]RouteTimeouts{
+			"Lock":        {Read: 3 * time.Second},
+			"Transaction": {Announce: 4 * time.Second},
+		},
+	}
+
+	assert.Equal(t, time.Second, timeouts.timeout(http.MethodGet, "/account/SAONSOGFZZHNEIBRYXHDTDTBR2YSAXKTITRFHG2Y"))
+	assert.Equal(t, 3*time.Second, timeouts.timeout(http.MethodGet, "/account/SAONSOGFZZHNEIBRYXHDTDTBR2YSAXKTITRFHG2Y/lock/hash"))
+	assert.Equal(t, time.Second, timeouts.timeout(http.MethodPost, "/transaction/statuses"))
+	assert.Equal(t, 4*time.Second, timeouts.timeout(http.MethodPut, "/transaction"))
+	assert.Equal(t, 2*time.Second, timeouts.timeout(http.MethodPut, "/unknown"))
+}
+
+func TestServiceOf(t *testing.T) {
+	assert.Equal(t, "Account", serviceOf("/account/KEY/transactions?pageSize=10"))
+	assert.Equal(t, "Namespace", serviceOf("/account/namespaces"))
+	assert.Equal(t, "Metadata", serviceOf("/mosaic/ID/metadata"))
+	assert.Equal(t, "Blockchain", serviceOf("/chain/height"))
+	assert.Equal(t, "Network", serviceOf("/config/1"))
+	assert.Equal(t, "", serviceOf("/unknown"))
+}
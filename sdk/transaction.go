@@ -125,6 +125,11 @@ func (txs *TransactionService) announceTransaction(ctx context.Context, tx inter
 		Message string `json:"message"`
 	}{}
 
+	// transaction signed for pinned network must not be announced to node of other one
+	if err := txs.client.useVerifiedNode(ctx); err != nil {
+		return "", err
+	}
+
 	resp, err := txs.client.doNewRequest(ctx, http.MethodPut, path, tx, &m)
 	if err != nil {
 		return "", err
@@ -0,0 +1,58 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportConfig describes connection settings, which are shared by REST and websocket clients
+// `Proxy` is http.ProxyFromEnvironment if nil
+// `Header` is sent with every REST request and websocket handshake, e.g. authorization for gateway
+// `HandshakeTimeout` limits TLS handshake of REST requests and handshake of websocket connection
+type TransportConfig struct {
+	TLSConfig        *tls.Config
+	Proxy            func(*http.Request) (*url.URL, error)
+	DialContext      func(ctx context.Context, network, addr string) (net.Conn, error)
+	Header           http.Header
+	HandshakeTimeout time.Duration
+}
+
+// returns http.Client with transport configured by passed settings, settings of http.DefaultTransport are used for the rest
+func (t *TransportConfig) NewHttpClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if t.TLSConfig != nil {
+		transport.TLSClientConfig = t.TLSConfig
+	}
+
+	if t.Proxy != nil {
+		transport.Proxy = t.Proxy
+	}
+
+	if t.DialContext != nil {
+		transport.DialContext = t.DialContext
+	}
+
+	if t.HandshakeTimeout != 0 {
+		transport.TLSHandshakeTimeout = t.HandshakeTimeout
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// adds configured headers to passed ones
+func (t *TransportConfig) addHeader(header http.Header) {
+	for key, values := range t.Header {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+}
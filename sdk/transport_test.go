@@ -0,0 +1,41 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransportConfig_NewHttpClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"height": [42, 0]}`))
+	}))
+	defer server.Close()
+
+	conf, err := NewConfigWithReputation([]string{server.URL}, PublicTest, &defaultRepConfig, DefaultWebsocketReconnectionTimeout, nil, DefaultFeeCalculationStrategy)
+	assert.Nil(t, err)
+
+	// certificate of test server is not trusted by default
+	_, err = NewClient(nil, conf).Blockchain.GetBlockchainHeight(ctx)
+	assert.NotNil(t, err)
+
+	conf.Transport = &TransportConfig{
+		TLSConfig: server.Client().Transport.(*http.Transport).TLSClientConfig,
+		Header:    http.Header{"Authorization": []string{"Bearer token"}},
+	}
+
+	height, err := NewClient(nil, conf).Blockchain.GetBlockchainHeight(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, Height(42), height)
+}
@@ -0,0 +1,437 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	hdlrs "github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/handlers"
+)
+
+const (
+	backfillBlocksLimit          sdk.Amount = 100
+	backfillTransactionsPageSize            = 100
+	// delivered messages older than this number of blocks are not remembered for deduplication
+	backfillDedupWindow sdk.Height = 360
+)
+
+var (
+	ErrNilBackfillSource     = errors.New("blockchain and account sources should not be nil")
+	ErrBackfillCursorStalled = errors.New("page of account transactions does not advance cursor")
+	ErrBackfillTooLate       = errors.New("backfill should be enabled before block or confirmed transaction handlers are added and Listen is called")
+	// transactions of account, which has not announced any transaction yet, can't be fetched, so its gap is not filled
+	ErrBackfillUnknownPublicKey = errors.New("public key of account is not known to the network, missed transactions are not fetched")
+)
+
+// BlockchainSource is the part of *sdk.BlockchainService, which is used to fetch missed blocks
+type BlockchainSource interface {
+	GetBlockchainHeight(ctx context.Context) (sdk.Height, error)
+	GetBlocksByHeightWithLimit(ctx context.Context, height sdk.Height, limit sdk.Amount) ([]*sdk.BlockInfo, error)
+}
+
+// AccountSource is the part of *sdk.AccountService, which is used to fetch missed confirmed transactions
+type AccountSource interface {
+	GetAccountInfo(ctx context.Context, address *sdk.Address) (*sdk.AccountInfo, error)
+	Transactions(ctx context.Context, account *sdk.PublicAccount, opt *sdk.AccountTransactionsOption) ([]sdk.Transaction, error)
+}
+
+// BackfillOptions configures fetching of messages missed while websocket was disconnected
+// `ErrorHandler` is called when missed messages can't be fetched, live messages are resumed anyway
+type BackfillOptions struct {
+	Blockchain   BlockchainSource
+	Account      AccountSource
+	ErrorHandler func(error)
+}
+
+// backfill remembers the last seen block height and recently delivered messages,
+// so messages fetched over REST after reconnection are not delivered twice
+type backfill struct {
+	*BackfillOptions
+
+	sync.Mutex
+	lastHeight   sdk.Height
+	blocks       map[sdk.Height]struct{}
+	transactions map[string]sdk.Height
+	accounts     map[string]*sdk.PublicAccount
+}
+
+func newBackfill(opts *BackfillOptions) *backfill {
+	return &backfill{
+		BackfillOptions: opts,
+		blocks:          make(map[sdk.Height]struct{}),
+		transactions:    make(map[string]sdk.Height),
+		accounts:        make(map[string]*sdk.PublicAccount),
+	}
+}
+
+func (b *backfill) height() sdk.Height {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.lastHeight
+}
+
+// returns true if block at passed height is not delivered yet and remembers it
+func (b *backfill) markBlock(height sdk.Height) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	if _, ok := b.blocks[height]; ok {
+		return false
+	}
+
+	b.blocks[height] = struct{}{}
+
+	if height > b.lastHeight {
+		b.lastHeight = height
+		b.prune()
+	}
+
+	return true
+}
+
+// returns true if transaction is not delivered yet for passed address and remembers it
+// transactions without meta information are always delivered
+func (b *backfill) markTransaction(address *sdk.Address, tx sdk.Transaction) bool {
+	info := tx.GetAbstractTransaction().TransactionInfo
+	if info == nil || info.TransactionHash == nil {
+		return true
+	}
+
+	key := address.Address + info.TransactionHash.String()
+
+	b.Lock()
+	defer b.Unlock()
+
+	if _, ok := b.transactions[key]; ok {
+		return false
+	}
+
+	b.transactions[key] = info.Height
+
+	return true
+}
+
+func (b *backfill) prune() {
+	if b.lastHeight <= backfillDedupWindow {
+		return
+	}
+
+	minHeight := b.lastHeight - backfillDedupWindow
+
+	for height := range b.blocks {
+		if height < minHeight {
+			delete(b.blocks, height)
+		}
+	}
+
+	for key, height := range b.transactions {
+		if height < minHeight {
+			delete(b.transactions, key)
+		}
+	}
+}
+
+func (b *backfill) reportError(err error) {
+	if b.ErrorHandler != nil {
+		b.ErrorHandler(err)
+	}
+}
+
+// returns blocks in range (from, to] in ascending order of height
+func (b *backfill) missedBlocks(ctx context.Context, from, to sdk.Height) ([]*sdk.BlockInfo, error) {
+	blocks := make([]*sdk.BlockInfo, 0, to-from)
+
+	for height := from + 1; height <= to; height += sdk.Height(backfillBlocksLimit) {
+		infos, err := b.Blockchain.GetBlocksByHeightWithLimit(ctx, height, backfillBlocksLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range infos {
+			if info.Height > from && info.Height <= to {
+				blocks = append(blocks, info)
+			}
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Height < blocks[j].Height
+	})
+
+	return blocks, nil
+}
+
+// returns confirmed transactions of passed address in range of heights (from, to] in ascending order
+func (b *backfill) missedTransactions(ctx context.Context, address *sdk.Address, from, to sdk.Height) ([]sdk.Transaction, error) {
+	account, err := b.publicAccount(ctx, address)
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	opt := &sdk.AccountTransactionsOption{
+		PageSize: backfillTransactionsPageSize,
+		Ordering: sdk.TRANSACTION_ORDER_DESC,
+	}
+
+	txs := make([]sdk.Transaction, 0)
+
+Pages:
+	for {
+		page, err := b.Account.Transactions(ctx, account, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		cursor := opt.Id
+
+		for _, tx := range page {
+			info := tx.GetAbstractTransaction().TransactionInfo
+			if info == nil {
+				continue
+			}
+
+			if info.Height <= from {
+				break Pages
+			}
+
+			if info.Height <= to {
+				txs = append(txs, tx)
+			}
+
+			opt.Id = info.Id
+		}
+
+		if len(page) < backfillTransactionsPageSize {
+			break
+		}
+
+		// page without transaction infos would be requested again forever
+		if opt.Id == cursor {
+			return nil, ErrBackfillCursorStalled
+		}
+	}
+
+	for i, j := 0, len(txs)-1; i < j; i, j = i+1, j-1 {
+		txs[i], txs[j] = txs[j], txs[i]
+	}
+
+	return txs, nil
+}
+
+// returns nil if account with passed address is not known to the network, so it has no transactions
+// ErrBackfillUnknownPublicKey is returned if account has only received transactions, they can't be fetched without public key
+func (b *backfill) publicAccount(ctx context.Context, address *sdk.Address) (*sdk.PublicAccount, error) {
+	b.Lock()
+	account, ok := b.accounts[address.Address]
+	b.Unlock()
+
+	if ok {
+		return account, nil
+	}
+
+	info, err := b.Account.GetAccountInfo(ctx, address)
+	if err != nil {
+		if e, ok := err.(*sdk.HttpError); ok && e.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if info.PublicKeyHeight == 0 {
+		return nil, errors.Wrapf(ErrBackfillUnknownPublicKey, "address %s", address.Address)
+	}
+
+	account, err = sdk.NewAccountFromPublicKey(info.PublicKey, address.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Lock()
+	b.accounts[address.Address] = account
+	b.Unlock()
+
+	return account, nil
+}
+
+// backfillBlockHandler drops live blocks, which were already delivered by backfill
+type backfillBlockHandler struct {
+	hdlrs.Handler
+	backfill *backfill
+}
+
+func (h *backfillBlockHandler) Handle(address *sdk.Address, resp []byte) bool {
+	block, err := sdk.MapBlock(resp)
+	if err != nil {
+		panic(errors.Wrap(err, "message mapping"))
+	}
+
+	if !h.backfill.markBlock(block.Height) {
+		return true
+	}
+
+	return h.Handler.Handle(address, resp)
+}
+
+// backfillConfirmedAddedHandler drops live transactions, which were already delivered by backfill
+type backfillConfirmedAddedHandler struct {
+	hdlrs.Handler
+	backfill      *backfill
+	messageMapper sdk.ConfirmedAddedMapper
+}
+
+func (h *backfillConfirmedAddedHandler) Handle(address *sdk.Address, resp []byte) bool {
+	tx, err := h.messageMapper.MapConfirmedAdded(resp)
+	if err != nil {
+		panic(errors.Wrap(err, "message mapper error"))
+	}
+
+	if !h.backfill.markTransaction(address, tx) {
+		return true
+	}
+
+	return h.Handler.Handle(address, resp)
+}
+
+// enables fetching of blocks and confirmed transactions missed while websocket was disconnected
+// after reconnection they are delivered to handlers in ascending order of height before live messages are resumed
+// block topic is subscribed to remember the last seen height
+// it should be called once right after client is created: ErrBackfillTooLate is returned if block or confirmed transaction handlers
+// are already added or client is listening, because messages delivered before could not be deduplicated
+func (c *CatapultWebsocketClientImpl) EnableBackfill(opts *BackfillOptions) error {
+	if opts == nil || opts.Blockchain == nil || opts.Account == nil {
+		return ErrNilBackfillSource
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClientClosed
+	}
+
+	if c.alreadyListening || c.blockSubscriber.HasHandlers() || len(c.confirmedAddedSubscribers.GetAddresses()) > 0 {
+		c.mu.Unlock()
+		return ErrBackfillTooLate
+	}
+
+	c.backfill = newBackfill(opts)
+	c.topicHandlers.SetTopicHandler(pathBlock, c.newBlockTopicHandler())
+	c.topicHandlers.SetTopicHandler(pathConfirmedAdded, c.newConfirmedAddedTopicHandler())
+	c.mu.Unlock()
+
+	return c.AddBlockHandlers(func(*sdk.BlockInfo) bool { return false })
+}
+
+// fetches messages after the last seen height and delivers them to handlers in ascending order of height
+func (c *CatapultWebsocketClientImpl) fillGap(ctx context.Context) error {
+	from := c.backfill.height()
+	if from == 0 {
+		return nil
+	}
+
+	to, err := c.backfill.Blockchain.GetBlockchainHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	if to <= from {
+		return nil
+	}
+
+	blocks, err := c.backfill.missedBlocks(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range blocks {
+		if c.backfill.markBlock(block.Height) {
+			c.deliverBlock(block)
+		}
+	}
+
+	for _, value := range c.confirmedAddedSubscribers.GetAddresses() {
+		address := &sdk.Address{Type: c.config.NetworkType, Address: value}
+
+		txs, err := c.backfill.missedTransactions(ctx, address, from, to)
+		if errors.Cause(err) == ErrBackfillUnknownPublicKey {
+			// other addresses are still filled
+			c.backfill.reportError(err)
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, tx := range txs {
+			if c.backfill.markTransaction(address, tx) {
+				c.deliverConfirmedAdded(address, tx)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *CatapultWebsocketClientImpl) deliverBlock(block *sdk.BlockInfo) {
+	mapper := sdk.BlockMapperFn(func([]byte) (*sdk.BlockInfo, error) {
+		return block, nil
+	})
+
+	c.replay(pathBlock, nil, pathBlock, hdlrs.NewBlockHandler(mapper, c.blockSubscriber))
+}
+
+func (c *CatapultWebsocketClientImpl) deliverConfirmedAdded(address *sdk.Address, tx sdk.Transaction) {
+	mapper := confirmedAddedMapperFn(func([]byte) (sdk.Transaction, error) {
+		return tx, nil
+	})
+
+	topic := Path(fmt.Sprintf("%s/%s", pathConfirmedAdded, address.Address))
+	c.replay(pathConfirmedAdded, address, topic, hdlrs.NewConfirmedAddedHandler(mapper, c.confirmedAddedSubscribers))
+}
+
+// delivers message fetched over REST like live one: handler is wrapped by middlewares and it is called by worker of topic,
+// so it is handled after live messages of topic, which are already queued, and its panic is recovered by dispatcher
+// middlewares get nil body, because message is already mapped, topic is unsubscribed if handler does not need it anymore
+func (c *CatapultWebsocketClientImpl) replay(path Path, address *sdk.Address, topic Path, handler hdlrs.Handler) {
+	c.mu.Lock()
+	d := c.dispatcher
+	uid := c.UID
+	c.mu.Unlock()
+
+	handler = c.middlewares.wrap(path, handler)
+
+	f := func() {
+		if handler.Handle(address, nil) {
+			return
+		}
+
+		if err := c.messagePublisher.PublishUnsubscribeMessage(uid, topic); err != nil {
+			panic(errors.Wrap(err, "unsubscribing from topic"))
+		}
+	}
+
+	if d == nil {
+		// client is not listening yet, so there are no queued messages
+		(&dispatcher{opts: c.dispatch.withDefaults()}).protect(f)
+		return
+	}
+
+	d.replay(c.ctx, string(path), address, f)
+}
+
+type confirmedAddedMapperFn func(m []byte) (sdk.Transaction, error)
+
+func (f confirmedAddedMapperFn) MapConfirmedAdded(m []byte) (sdk.Transaction, error) {
+	return f(m)
+}
@@ -0,0 +1,240 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	hdlrs "github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/handlers"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/subscribers"
+)
+
+const testBackfillPublicKey = "321DE652C4D3362FC2DDF7800F6582F4A10CFEA134B81F8AB6E4BE78BBA4D18E"
+
+type testBlockchainSource struct {
+	height sdk.Height
+}
+
+func (s *testBlockchainSource) GetBlockchainHeight(_ context.Context) (sdk.Height, error) {
+	return s.height, nil
+}
+
+// returns blocks in descending order as REST api does
+func (s *testBlockchainSource) GetBlocksByHeightWithLimit(_ context.Context, height sdk.Height, limit sdk.Amount) ([]*sdk.BlockInfo, error) {
+	blocks := make([]*sdk.BlockInfo, 0)
+
+	for h := height + sdk.Height(limit) - 1; h >= height; h-- {
+		if h <= s.height {
+			blocks = append(blocks, &sdk.BlockInfo{Height: h})
+		}
+	}
+
+	return blocks, nil
+}
+
+type testAccountSource struct {
+	transactions []sdk.Transaction
+	// account has only received transactions
+	receiveOnly bool
+}
+
+func (s *testAccountSource) GetAccountInfo(_ context.Context, address *sdk.Address) (*sdk.AccountInfo, error) {
+	if s.receiveOnly {
+		return &sdk.AccountInfo{Address: address}, nil
+	}
+
+	return &sdk.AccountInfo{Address: address, PublicKey: testBackfillPublicKey, PublicKeyHeight: 1}, nil
+}
+
+func (s *testAccountSource) Transactions(_ context.Context, _ *sdk.PublicAccount, _ *sdk.AccountTransactionsOption) ([]sdk.Transaction, error) {
+	return s.transactions, nil
+}
+
+func newTestTransferTransaction(height sdk.Height, hash sdk.Hash) sdk.Transaction {
+	return &sdk.TransferTransaction{
+		AbstractTransaction: sdk.AbstractTransaction{
+			TransactionInfo: &sdk.TransactionInfo{
+				Height:          height,
+				Id:              fmt.Sprintf("id%d", height),
+				TransactionHash: &hash,
+			},
+		},
+	}
+}
+
+func TestCatapultWebsocketClientImpl_fillGap(t *testing.T) {
+	ctx := context.Background()
+	address := &sdk.Address{Type: sdk.PublicTest, Address: "test-address"}
+
+	messagePublisher := new(MockMessagePublisher)
+	messagePublisher.On("PublishSubscribeMessage", "123456", pathBlock).Return(nil).Once()
+	messagePublisher.On("PublishSubscribeMessage", "123456", Path(fmt.Sprintf("%s/%s", pathConfirmedAdded, address.Address))).Return(nil).Once()
+
+	c := &CatapultWebsocketClientImpl{
+		config:                    &sdk.Config{NetworkType: sdk.PublicTest},
+		UID:                       "123456",
+		topicHandlers:             make(topicHandlers),
+		blockSubscriber:           subscribers.NewBlock(),
+		confirmedAddedSubscribers: subscribers.NewConfirmedAdded(),
+		messagePublisher:          messagePublisher,
+	}
+
+	accounts := &testAccountSource{
+		transactions: []sdk.Transaction{
+			newTestTransferTransaction(12, sdk.Hash{3}),
+			newTestTransferTransaction(11, sdk.Hash{2}),
+			newTestTransferTransaction(10, sdk.Hash{1}),
+		},
+	}
+
+	assert.Equal(t, ErrNilBackfillSource, c.EnableBackfill(&BackfillOptions{Account: accounts}))
+	assert.Nil(t, c.EnableBackfill(&BackfillOptions{
+		Blockchain: &testBlockchainSource{height: 12},
+		Account:    accounts,
+	}))
+	assert.IsType(t, &backfillBlockHandler{}, c.topicHandlers.GetHandler(pathBlock).Handler)
+
+	// handlers added before would miss deduplication
+	assert.Equal(t, ErrBackfillTooLate, c.EnableBackfill(&BackfillOptions{
+		Blockchain: &testBlockchainSource{height: 12},
+		Account:    accounts,
+	}))
+
+	heights := make([]sdk.Height, 0)
+	assert.Nil(t, c.AddBlockHandlers(func(block *sdk.BlockInfo) bool {
+		heights = append(heights, block.Height)
+		return false
+	}))
+
+	txHeights := make([]sdk.Height, 0)
+	assert.Nil(t, c.AddConfirmedAddedHandlers(address, func(tx sdk.Transaction) bool {
+		txHeights = append(txHeights, tx.GetAbstractTransaction().Height)
+		return false
+	}))
+
+	// nothing is fetched before the first block is seen
+	assert.Nil(t, c.fillGap(ctx))
+	assert.Empty(t, heights)
+
+	assert.True(t, c.backfill.markBlock(10))
+	assert.True(t, c.backfill.markTransaction(address, accounts.transactions[2]))
+
+	assert.Nil(t, c.fillGap(ctx))
+	assert.Equal(t, []sdk.Height{11, 12}, heights)
+	assert.Equal(t, []sdk.Height{11, 12}, txHeights)
+	assert.Equal(t, sdk.Height(12), c.backfill.height())
+
+	// live messages delivered by backfill are dropped
+	assert.False(t, c.backfill.markBlock(12))
+	assert.False(t, c.backfill.markTransaction(address, accounts.transactions[0]))
+	assert.True(t, c.backfill.markBlock(13))
+
+	messagePublisher.AssertExpectations(t)
+}
+
+func TestCatapultWebsocketClientImpl_fillGapUsesMiddlewaresAndRecovery(t *testing.T) {
+	ctx := context.Background()
+
+	messagePublisher := new(MockMessagePublisher)
+	messagePublisher.On("PublishSubscribeMessage", "123456", pathBlock).Return(nil).Once()
+
+	c := &CatapultWebsocketClientImpl{
+		config:                    &sdk.Config{NetworkType: sdk.PublicTest},
+		UID:                       "123456",
+		topicHandlers:             make(topicHandlers),
+		blockSubscriber:           subscribers.NewBlock(),
+		confirmedAddedSubscribers: subscribers.NewConfirmedAdded(),
+		messagePublisher:          messagePublisher,
+	}
+
+	var panics []error
+	c.SetDispatchOptions(&DispatchOptions{PanicHandler: func(err error) {
+		panics = append(panics, err)
+	}})
+
+	var paths []Path
+	c.Use(func(path Path, next hdlrs.Handler) hdlrs.Handler {
+		return HandlerFunc(func(address *sdk.Address, resp []byte) bool {
+			paths = append(paths, path)
+			return next.Handle(address, resp)
+		})
+	})
+
+	assert.Nil(t, c.EnableBackfill(&BackfillOptions{
+		Blockchain: &testBlockchainSource{height: 12},
+		Account:    &testAccountSource{},
+	}))
+
+	heights := make([]sdk.Height, 0)
+	assert.Nil(t, c.AddBlockHandlers(func(block *sdk.BlockInfo) bool {
+		if block.Height == 11 {
+			panic("broken handler")
+		}
+
+		heights = append(heights, block.Height)
+		return false
+	}))
+
+	assert.True(t, c.backfill.markBlock(10))
+	assert.Nil(t, c.fillGap(ctx))
+
+	assert.Equal(t, []sdk.Height{12}, heights)
+	assert.Equal(t, []Path{pathBlock, pathBlock}, paths)
+	assert.Len(t, panics, 1)
+	assert.IsType(t, &hdlrs.PanicError{}, panics[0])
+}
+
+func TestBackfill_missedTransactionsStalledCursor(t *testing.T) {
+	page := make([]sdk.Transaction, backfillTransactionsPageSize)
+	for i := range page {
+		page[i] = &sdk.TransferTransaction{}
+	}
+
+	b := newBackfill(&BackfillOptions{
+		Blockchain: &testBlockchainSource{},
+		Account:    &testAccountSource{transactions: page},
+	})
+
+	_, err := b.missedTransactions(context.Background(), &sdk.Address{Type: sdk.PublicTest, Address: "test-address"}, 10, 12)
+	assert.Equal(t, ErrBackfillCursorStalled, err)
+}
+
+func TestCatapultWebsocketClientImpl_fillGapReportsReceiveOnlyAccount(t *testing.T) {
+	address := &sdk.Address{Type: sdk.PublicTest, Address: "test-address"}
+
+	messagePublisher := new(MockMessagePublisher)
+	messagePublisher.On("PublishSubscribeMessage", "123456", pathBlock).Return(nil).Once()
+	messagePublisher.On("PublishSubscribeMessage", "123456", Path(fmt.Sprintf("%s/%s", pathConfirmedAdded, address.Address))).Return(nil).Once()
+
+	c := &CatapultWebsocketClientImpl{
+		config:                    &sdk.Config{NetworkType: sdk.PublicTest},
+		UID:                       "123456",
+		topicHandlers:             make(topicHandlers),
+		blockSubscriber:           subscribers.NewBlock(),
+		confirmedAddedSubscribers: subscribers.NewConfirmedAdded(),
+		messagePublisher:          messagePublisher,
+	}
+
+	var reported []error
+	assert.Nil(t, c.EnableBackfill(&BackfillOptions{
+		Blockchain:   &testBlockchainSource{height: 12},
+		Account:      &testAccountSource{receiveOnly: true},
+		ErrorHandler: func(err error) { reported = append(reported, err) },
+	}))
+	assert.Nil(t, c.AddConfirmedAddedHandlers(address, func(sdk.Transaction) bool { return false }))
+
+	assert.True(t, c.backfill.markBlock(10))
+	assert.Nil(t, c.fillGap(context.Background()))
+
+	assert.Len(t, reported, 1)
+	assert.Equal(t, ErrBackfillUnknownPublicKey, errors.Cause(reported[0]))
+	assert.Contains(t, reported[0].Error(), address.Address)
+}
@@ -0,0 +1,353 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/subscribers"
+)
+
+const DefaultChannelBufferSize = 64
+
+// OverflowPolicy describes what channel subscription does with new message when buffer of the channel is full
+type OverflowPolicy uint8
+
+const (
+	// handler waits until the message is read from the channel or subscription is cancelled
+	BlockOnFull OverflowPolicy = iota
+	// new message is dropped
+	DropNewest
+	// the oldest message in the buffer is dropped to make a room for the new one
+	DropOldest
+)
+
+// ChannelOptions configures channel subscriptions, DefaultChannelBufferSize and BlockOnFull are used if options are nil
+// `Filter` is applied to transactions of ConfirmedAdded, UnconfirmedAdded and PartialAdded subscriptions before they are sent into the channel
+type ChannelOptions struct {
+	BufferSize int
+	Policy     OverflowPolicy
+	Filter     TransactionFilter
+}
+
+// returns true if transaction is matched by filter of options
+func (o *ChannelOptions) match(tx sdk.Transaction) bool {
+	return o.Filter == nil || o.Filter(tx)
+}
+
+func (o *ChannelOptions) orDefault() *ChannelOptions {
+	if o == nil {
+		return &ChannelOptions{BufferSize: DefaultChannelBufferSize, Policy: BlockOnFull}
+	}
+
+	return o
+}
+
+// returns channel of new blocks, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribeBlock(ctx context.Context, opts *ChannelOptions) (<-chan *sdk.BlockInfo, error) {
+	opts = opts.orDefault()
+	ch := make(chan *sdk.BlockInfo, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.BlockHandler{func(block *sdk.BlockInfo) bool {
+		return sub.deliver(block)
+	}}
+
+	if err := c.AddBlockHandlers(handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemoveBlockHandlers(&handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of ConfirmedAdded messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribeConfirmedAdded(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan sdk.Transaction, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan sdk.Transaction, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.ConfirmedAddedHandler{func(tx sdk.Transaction) bool {
+		if !opts.match(tx) {
+			return false
+		}
+
+		return sub.deliver(tx)
+	}}
+
+	if err := c.AddConfirmedAddedHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemoveConfirmedAddedHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of UnconfirmedAdded messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribeUnconfirmedAdded(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan sdk.Transaction, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan sdk.Transaction, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.UnconfirmedAddedHandler{func(tx sdk.Transaction) bool {
+		if !opts.match(tx) {
+			return false
+		}
+
+		return sub.deliver(tx)
+	}}
+
+	if err := c.AddUnconfirmedAddedHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemoveUnconfirmedAddedHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of UnconfirmedRemoved messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribeUnconfirmedRemoved(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.UnconfirmedRemoved, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan *sdk.UnconfirmedRemoved, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.UnconfirmedRemovedHandler{func(info *sdk.UnconfirmedRemoved) bool {
+		return sub.deliver(info)
+	}}
+
+	if err := c.AddUnconfirmedRemovedHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemoveUnconfirmedRemovedHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of PartialAdded messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribePartialAdded(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.AggregateTransaction, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan *sdk.AggregateTransaction, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.PartialAddedHandler{func(tx *sdk.AggregateTransaction) bool {
+		if !opts.match(tx) {
+			return false
+		}
+
+		return sub.deliver(tx)
+	}}
+
+	if err := c.AddPartialAddedHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemovePartialAddedHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of PartialRemoved messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribePartialRemoved(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.PartialRemovedInfo, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan *sdk.PartialRemovedInfo, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.PartialRemovedHandler{func(info *sdk.PartialRemovedInfo) bool {
+		return sub.deliver(info)
+	}}
+
+	if err := c.AddPartialRemovedHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemovePartialRemovedHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of Status messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribeStatus(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.StatusInfo, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan *sdk.StatusInfo, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.StatusHandler{func(status *sdk.StatusInfo) bool {
+		return sub.deliver(status)
+	}}
+
+	if err := c.AddStatusHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemoveStatusHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// returns channel of Cosignature messages for passed address, which is closed when passed context is done
+// handler is removed and topic is unsubscribed when the last subscription for address is cancelled
+func (c *CatapultWebsocketClientImpl) SubscribeCosignature(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.SignerInfo, error) {
+	if address == nil {
+		return nil, sdk.ErrNilAddress
+	}
+
+	opts = opts.orDefault()
+	ch := make(chan *sdk.SignerInfo, opts.BufferSize)
+	sub := newChannelSubscription(ctx, ch, opts.Policy)
+
+	handlers := []subscribers.CosignatureHandler{func(info *sdk.SignerInfo) bool {
+		return sub.deliver(info)
+	}}
+
+	if err := c.AddCosignatureHandlers(address, handlers...); err != nil {
+		return nil, err
+	}
+
+	go sub.watch(c.done(), func() {
+		_ = c.RemoveCosignatureHandlers(address, &handlers[0])
+	})
+
+	return ch, nil
+}
+
+// done returns channel, which is closed when client is closed
+func (c *CatapultWebsocketClientImpl) done() <-chan struct{} {
+	if c.ctx == nil {
+		return nil
+	}
+
+	return c.ctx.Done()
+}
+
+// channelSubscription delivers messages into typed channel, reflection is used to share it between all message types
+type channelSubscription struct {
+	ctx    context.Context
+	ch     reflect.Value
+	policy OverflowPolicy
+	stop   chan struct{}
+
+	sync.Mutex
+	closed bool
+}
+
+func newChannelSubscription(ctx context.Context, ch interface{}, policy OverflowPolicy) *channelSubscription {
+	return &channelSubscription{
+		ctx:    ctx,
+		ch:     reflect.ValueOf(ch),
+		policy: policy,
+		stop:   make(chan struct{}),
+	}
+}
+
+// delivers passed message according to overflow policy and returns true if subscription is cancelled
+func (s *channelSubscription) deliver(msg interface{}) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed || s.ctx.Err() != nil {
+		return true
+	}
+
+	value := reflect.Zero(s.ch.Type().Elem())
+	if msg != nil {
+		value = reflect.ValueOf(msg)
+	}
+
+	switch s.policy {
+	case DropNewest:
+		s.ch.TrySend(value)
+	case DropOldest:
+		for !s.ch.TrySend(value) {
+			if _, ok := s.ch.TryRecv(); !ok {
+				break
+			}
+		}
+	default:
+		chosen, _, _ := reflect.Select([]reflect.SelectCase{
+			{Dir: reflect.SelectSend, Chan: s.ch, Send: value},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.ctx.Done())},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.stop)},
+		})
+
+		return chosen != 0
+	}
+
+	return false
+}
+
+// waits until subscription context is done, removes handler and closes the channel
+// if client is closed before, only the channel is closed
+func (s *channelSubscription) watch(clientDone <-chan struct{}, remove func()) {
+	select {
+	case <-s.ctx.Done():
+		select {
+		case <-clientDone:
+		default:
+			remove()
+		}
+	case <-clientDone:
+	}
+
+	// interrupts blocked delivery before taking the lock
+	close(s.stop)
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.closed = true
+	s.ch.Close()
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/subscribers"
+)
+
+func newChannelTestClient(messagePublisher MessagePublisher) *CatapultWebsocketClientImpl {
+	return &CatapultWebsocketClientImpl{
+		ctx:               context.Background(),
+		UID:               "123456",
+		topicHandlers:     make(topicHandlers),
+		statusSubscribers: subscribers.NewStatus(),
+		messagePublisher:  messagePublisher,
+	}
+}
+
+func statusHandler(t *testing.T, c *CatapultWebsocketClientImpl, address *sdk.Address) subscribers.StatusHandler {
+	for h := range c.statusSubscribers.GetHandlers(address) {
+		return *h
+	}
+
+	t.Fatal("status handler is not registered")
+	return nil
+}
+
+func TestCatapultWebsocketClientImpl_SubscribeStatus(t *testing.T) {
+	address := &sdk.Address{Address: "test-address"}
+	topic := Path(fmt.Sprintf("%s/%s", pathStatus, address.Address))
+
+	messagePublisher := new(MockMessagePublisher)
+	messagePublisher.On("PublishSubscribeMessage", "123456", topic).Return(nil).Once()
+	messagePublisher.On("PublishUnsubscribeMessage", "123456", topic).Return(nil).Once()
+
+	c := newChannelTestClient(messagePublisher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	statuses, err := c.SubscribeStatus(ctx, address, nil)
+	assert.Nil(t, err)
+
+	status := &sdk.StatusInfo{Status: "Failure_Core_Insufficient_Balance"}
+	assert.False(t, statusHandler(t, c, address)(status))
+	assert.Equal(t, status, <-statuses)
+
+	cancel()
+
+	select {
+	case _, ok := <-statuses:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel is not closed after context cancellation")
+	}
+
+	assert.False(t, c.statusSubscribers.HasHandlers(address))
+	messagePublisher.AssertExpectations(t)
+}
+
+func TestCatapultWebsocketClientImpl_SubscribeStatus_OverflowPolicy(t *testing.T) {
+	address := &sdk.Address{Address: "test-address"}
+	first, second := &sdk.StatusInfo{Status: "first"}, &sdk.StatusInfo{Status: "second"}
+
+	tests := []struct {
+		policy   OverflowPolicy
+		expected *sdk.StatusInfo
+	}{
+		{DropNewest, first},
+		{DropOldest, second},
+	}
+
+	for _, tt := range tests {
+		messagePublisher := new(MockMessagePublisher)
+		messagePublisher.On("PublishSubscribeMessage", "123456", Path(fmt.Sprintf("%s/%s", pathStatus, address.Address))).Return(nil)
+
+		c := newChannelTestClient(messagePublisher)
+
+		statuses, err := c.SubscribeStatus(context.Background(), address, &ChannelOptions{BufferSize: 1, Policy: tt.policy})
+		assert.Nil(t, err)
+
+		handler := statusHandler(t, c, address)
+		assert.False(t, handler(first))
+		assert.False(t, handler(second))
+		assert.Equal(t, tt.expected, <-statuses)
+	}
+}
+
+func TestChannelSubscription_BlockOnFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sdk.StatusInfo)
+	sub := newChannelSubscription(ctx, ch, BlockOnFull)
+
+	go cancel()
+
+	// nobody reads the channel, so delivery is interrupted by cancellation and handler is removed
+	assert.True(t, sub.deliver(&sdk.StatusInfo{}))
+	assert.True(t, sub.deliver(&sdk.StatusInfo{}))
+}
@@ -8,8 +8,9 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net"
+	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -37,26 +38,41 @@ const (
 
 var (
 	ErrUnsupportedMessageType = errors.New("unsupported message type")
+	ErrClientClosed           = errors.New("websocket client is closed")
 )
 
 func NewClient(ctx context.Context, cfg *sdk.Config) (CatapultClient, error) {
+	return NewClientWithDialer(ctx, cfg, nil)
+}
+
+// returns client, which connects to nodes by passed dialer, dialer is built by NewDialer if nil
+// config's Transport headers are sent during handshake anyway
+// config is copied, so failover of websocket client does not change used node of HTTP client sharing it
+func NewClientWithDialer(ctx context.Context, cfg *sdk.Config, dialer *websocket.Dialer) (CatapultClient, error) {
+	conf := *cfg
+	cfg = &conf
+
 	ctx, cancelFunc := context.WithCancel(ctx)
 
-	conn, uid, err := connect(cfg)
+	connectFn := func(cfg *sdk.Config) (*websocket.Conn, string, error) {
+		return connectWithDialer(cfg, dialer)
+	}
+
+	conn, uid, err := connectFn(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	topicHandlers := make(topicHandlers)
+	topicHandlers := newSyncTopicHandlers(make(topicHandlers))
 	messagePublisher := newMessagePublisher(conn)
-	messageRouter := NewRouter(uid, messagePublisher, topicHandlers)
 
-	return &CatapultWebsocketClientImpl{
+	client := &CatapultWebsocketClientImpl{
 		config:     cfg,
 		conn:       conn,
 		ctx:        ctx,
 		cancelFunc: cancelFunc,
 		UID:        uid,
+		connectFn:  connectFn,
 
 		blockSubscriber:               subscribers.NewBlock(),
 		confirmedAddedSubscribers:     subscribers.NewConfirmedAdded(),
@@ -68,9 +84,13 @@ func NewClient(ctx context.Context, cfg *sdk.Config) (CatapultClient, error) {
 		cosignatureSubscribers:        subscribers.NewCosignature(),
 
 		topicHandlers:    topicHandlers,
-		messageRouter:    messageRouter,
 		messagePublisher: messagePublisher,
-	}, nil
+	}
+
+	// handlers are wrapped by middlewares only for routing, so client keeps storing unwrapped ones
+	client.messageRouter = NewRouter(uid, messagePublisher, withMiddlewares(topicHandlers, &client.middlewares))
+
+	return client, nil
 }
 
 type Client interface {
@@ -90,6 +110,33 @@ type CatapultClient interface {
 	AddPartialRemovedHandlers(address *sdk.Address, handlers ...subscribers.PartialRemovedHandler) error
 	AddStatusHandlers(address *sdk.Address, handlers ...subscribers.StatusHandler) error
 	AddCosignatureHandlers(address *sdk.Address, handlers ...subscribers.CosignatureHandler) error
+
+	// handlers are identified by pointers to elements of the slice, which was passed to Add*Handlers
+	RemoveBlockHandlers(handlers ...*subscribers.BlockHandler) error
+	RemoveConfirmedAddedHandlers(address *sdk.Address, handlers ...*subscribers.ConfirmedAddedHandler) error
+	RemoveUnconfirmedAddedHandlers(address *sdk.Address, handlers ...*subscribers.UnconfirmedAddedHandler) error
+	RemoveUnconfirmedRemovedHandlers(address *sdk.Address, handlers ...*subscribers.UnconfirmedRemovedHandler) error
+	RemovePartialAddedHandlers(address *sdk.Address, handlers ...*subscribers.PartialAddedHandler) error
+	RemovePartialRemovedHandlers(address *sdk.Address, handlers ...*subscribers.PartialRemovedHandler) error
+	RemoveStatusHandlers(address *sdk.Address, handlers ...*subscribers.StatusHandler) error
+	RemoveCosignatureHandlers(address *sdk.Address, handlers ...*subscribers.CosignatureHandler) error
+	UnsubscribeAddress(address *sdk.Address) error
+
+	// returned channels are closed when passed context is done
+	SubscribeBlock(ctx context.Context, opts *ChannelOptions) (<-chan *sdk.BlockInfo, error)
+	SubscribeConfirmedAdded(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan sdk.Transaction, error)
+	SubscribeUnconfirmedAdded(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan sdk.Transaction, error)
+	SubscribeUnconfirmedRemoved(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.UnconfirmedRemoved, error)
+	SubscribePartialAdded(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.AggregateTransaction, error)
+	SubscribePartialRemoved(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.PartialRemovedInfo, error)
+	SubscribeStatus(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.StatusInfo, error)
+	SubscribeCosignature(ctx context.Context, address *sdk.Address, opts *ChannelOptions) (<-chan *sdk.SignerInfo, error)
+
+	EnableBackfill(opts *BackfillOptions) error
+	SetReconnectionOptions(opts *ReconnectionOptions)
+	SetDispatchOptions(opts *DispatchOptions)
+	Use(middlewares ...Middleware)
+	SetObserver(o Observer)
 }
 
 type CatapultWebsocketClientImpl struct {
@@ -114,73 +161,105 @@ type CatapultWebsocketClientImpl struct {
 
 	connectFn        func(cfg *sdk.Config) (*websocket.Conn, string, error)
 	alreadyListening bool
+
+	backfill     *backfill
+	reconnection *ReconnectionOptions
+	dispatch     *DispatchOptions
+	dispatcher   *dispatcher
+	middlewares  middlewareChain
+	observer     Observer
+
+	// guards connection, subscriptions and options, so they can be changed from any goroutine while client listens
+	mu     sync.Mutex
+	closed bool
 }
 
+// reads messages and dispatches them to handlers until client is closed or reconnection is failed
 func (c *CatapultWebsocketClientImpl) Listen() {
-	if c.alreadyListening {
+	c.mu.Lock()
+	if c.alreadyListening || c.closed {
+		c.mu.Unlock()
 		return
 	}
 
 	c.alreadyListening = true
+	conn := c.conn
+	d := newDispatcher(c.messageRouter, c.dispatch)
+	c.dispatcher = d
+	c.mu.Unlock()
 
-	messagesChan := make(chan []byte)
+	d.start(c.ctx)
 
 	go func() {
 		defer c.cancelFunc()
 
-	ReadMessageLoop:
+		c.requestBlockGenerationTargetTime()
+		c.keepAlive(conn)
+
 		for {
-			_, resp, e := c.conn.ReadMessage()
+			_ = conn.SetReadDeadline(time.Now().Add(c.reconnectionOptions().StaleTimeout))
+
+			_, resp, e := conn.ReadMessage()
 			if e != nil {
-				if _, ok := e.(*net.OpError); ok {
+				if c.ctx.Err() != nil {
 					// Stop ReadMessage goroutine if user called Close function for websocket client
 					return
 				}
 
-				if _, ok := e.(*websocket.CloseError); ok {
-					// Start websocket reconnect processing if connection was closed
-					for range time.NewTicker(c.config.WsReconnectionTimeout).C {
-						if err := c.reconnect(); err != nil {
-							continue
-						}
+				// Connection is closed, broken or stale, so it is replaced by the new one
+				_ = conn.Close()
 
-						continue ReadMessageLoop
-					}
+				if err := c.reconnectWithBackoff(e); err != nil {
+					return
 				}
 
-				return
+				c.mu.Lock()
+				conn = c.conn
+				c.mu.Unlock()
+
+				c.keepAlive(conn)
+
+				continue
 			}
 
-			messagesChan <- resp
+			d.dispatch(c.ctx, resp)
 		}
 	}()
 
-	for {
-		select {
-		case <-c.ctx.Done():
-			if c.conn != nil {
-				if err := c.conn.Close(); err != nil {
-					panic(err)
-				}
-				c.conn = nil
-			}
-			return
-		case msg := <-messagesChan:
-			go c.messageRouter.RouteMessage(msg)
-		}
+	<-c.ctx.Done()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		// connection can be already closed by Close function
+		_ = c.conn.Close()
+		c.conn = nil
 	}
 }
 
+// configures delivery of messages to handlers, it should be called before Listen
+func (c *CatapultWebsocketClientImpl) SetDispatchOptions(opts *DispatchOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.dispatch = opts
+}
+
 func (c *CatapultWebsocketClientImpl) AddBlockHandlers(handlers ...subscribers.BlockHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
 
 	if !c.topicHandlers.HasHandler(pathBlock) {
-		c.topicHandlers.SetTopicHandler(pathBlock, &TopicHandler{
-			Handler: hdlrs.NewBlockHandler(sdk.BlockMapperFn(sdk.MapBlock), c.blockSubscriber),
-			Topic:   topicFormatFn(formatBlockTopic),
-		})
+		c.topicHandlers.SetTopicHandler(pathBlock, c.newBlockTopicHandler())
 	}
 
 	if !c.blockSubscriber.HasHandlers() {
@@ -197,15 +276,19 @@ func (c *CatapultWebsocketClientImpl) AddBlockHandlers(handlers ...subscribers.B
 }
 
 func (c *CatapultWebsocketClientImpl) AddConfirmedAddedHandlers(address *sdk.Address, handlers ...subscribers.ConfirmedAddedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
 
 	if !c.topicHandlers.HasHandler(pathConfirmedAdded) {
-		c.topicHandlers.SetTopicHandler(pathConfirmedAdded, &TopicHandler{
-			Handler: hdlrs.NewConfirmedAddedHandler(sdk.NewConfirmedAddedMapper(sdk.MapTransaction), c.confirmedAddedSubscribers),
-			Topic:   topicFormatFn(formatPlainTopic),
-		})
+		c.topicHandlers.SetTopicHandler(pathConfirmedAdded, c.newConfirmedAddedTopicHandler())
 	}
 
 	if !c.confirmedAddedSubscribers.HasHandlers(address) {
@@ -223,6 +306,13 @@ func (c *CatapultWebsocketClientImpl) AddConfirmedAddedHandlers(address *sdk.Add
 }
 
 func (c *CatapultWebsocketClientImpl) AddUnconfirmedAddedHandlers(address *sdk.Address, handlers ...subscribers.UnconfirmedAddedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
@@ -249,6 +339,13 @@ func (c *CatapultWebsocketClientImpl) AddUnconfirmedAddedHandlers(address *sdk.A
 }
 
 func (c *CatapultWebsocketClientImpl) AddUnconfirmedRemovedHandlers(address *sdk.Address, handlers ...subscribers.UnconfirmedRemovedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
@@ -275,6 +372,13 @@ func (c *CatapultWebsocketClientImpl) AddUnconfirmedRemovedHandlers(address *sdk
 }
 
 func (c *CatapultWebsocketClientImpl) AddPartialAddedHandlers(address *sdk.Address, handlers ...subscribers.PartialAddedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
@@ -301,6 +405,13 @@ func (c *CatapultWebsocketClientImpl) AddPartialAddedHandlers(address *sdk.Addre
 }
 
 func (c *CatapultWebsocketClientImpl) AddPartialRemovedHandlers(address *sdk.Address, handlers ...subscribers.PartialRemovedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
@@ -327,6 +438,13 @@ func (c *CatapultWebsocketClientImpl) AddPartialRemovedHandlers(address *sdk.Add
 }
 
 func (c *CatapultWebsocketClientImpl) AddStatusHandlers(address *sdk.Address, handlers ...subscribers.StatusHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
@@ -353,6 +471,13 @@ func (c *CatapultWebsocketClientImpl) AddStatusHandlers(address *sdk.Address, ha
 }
 
 func (c *CatapultWebsocketClientImpl) AddCosignatureHandlers(address *sdk.Address, handlers ...subscribers.CosignatureHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	if len(handlers) == 0 {
 		return nil
 	}
@@ -377,7 +502,350 @@ func (c *CatapultWebsocketClientImpl) AddCosignatureHandlers(address *sdk.Addres
 	return nil
 }
 
+// removes passed block handlers, handlers are identified by pointers to elements of slice passed to AddBlockHandlers
+// unsubscribe message is published when the last block handler is removed
+func (c *CatapultWebsocketClientImpl) RemoveBlockHandlers(handlers ...*subscribers.BlockHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.blockSubscriber.RemoveHandlers(handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	if err := c.messagePublisher.PublishUnsubscribeMessage(c.UID, pathBlock); err != nil {
+		return errors.Wrap(err, "publishing unsubscribe message into websocket")
+	}
+
+	return nil
+}
+
+func (c *CatapultWebsocketClientImpl) RemoveConfirmedAddedHandlers(address *sdk.Address, handlers ...*subscribers.ConfirmedAddedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.confirmedAddedSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathConfirmedAdded, address)
+}
+
+func (c *CatapultWebsocketClientImpl) RemoveUnconfirmedAddedHandlers(address *sdk.Address, handlers ...*subscribers.UnconfirmedAddedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.unconfirmedAddedSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathUnconfirmedAdded, address)
+}
+
+func (c *CatapultWebsocketClientImpl) RemoveUnconfirmedRemovedHandlers(address *sdk.Address, handlers ...*subscribers.UnconfirmedRemovedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.unconfirmedRemovedSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathUnconfirmedRemoved, address)
+}
+
+func (c *CatapultWebsocketClientImpl) RemovePartialAddedHandlers(address *sdk.Address, handlers ...*subscribers.PartialAddedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.partialAddedSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathPartialAdded, address)
+}
+
+func (c *CatapultWebsocketClientImpl) RemovePartialRemovedHandlers(address *sdk.Address, handlers ...*subscribers.PartialRemovedHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.partialRemovedSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathPartialRemoved, address)
+}
+
+func (c *CatapultWebsocketClientImpl) RemoveStatusHandlers(address *sdk.Address, handlers ...*subscribers.StatusHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.statusSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathStatus, address)
+}
+
+func (c *CatapultWebsocketClientImpl) RemoveCosignatureHandlers(address *sdk.Address, handlers ...*subscribers.CosignatureHandler) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	empty, err := c.cosignatureSubscribers.RemoveHandlers(address, handlers...)
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(pathCosignature, address)
+}
+
+// removes all handlers of all topics for passed address and publishes unsubscribe messages for them
+// lock is held during the whole call, so it is atomic with respect to Close and other subscription changes
+func (c *CatapultWebsocketClientImpl) UnsubscribeAddress(address *sdk.Address) error {
+	if address == nil {
+		return sdk.ErrNilAddress
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
+	if c.confirmedAddedSubscribers.HasHandlers(address) {
+		confirmedAddedHandlers := make([]*subscribers.ConfirmedAddedHandler, 0)
+		for h := range c.confirmedAddedSubscribers.GetHandlers(address) {
+			confirmedAddedHandlers = append(confirmedAddedHandlers, h)
+		}
+
+		empty, err := c.confirmedAddedSubscribers.RemoveHandlers(address, confirmedAddedHandlers...)
+		if err = c.unsubscribeIfEmpty(pathConfirmedAdded, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	if c.unconfirmedAddedSubscribers.HasHandlers(address) {
+		unconfirmedAddedHandlers := make([]*subscribers.UnconfirmedAddedHandler, 0)
+		for h := range c.unconfirmedAddedSubscribers.GetHandlers(address) {
+			unconfirmedAddedHandlers = append(unconfirmedAddedHandlers, h)
+		}
+
+		empty, err := c.unconfirmedAddedSubscribers.RemoveHandlers(address, unconfirmedAddedHandlers...)
+		if err = c.unsubscribeIfEmpty(pathUnconfirmedAdded, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	if c.unconfirmedRemovedSubscribers.HasHandlers(address) {
+		unconfirmedRemovedHandlers := make([]*subscribers.UnconfirmedRemovedHandler, 0)
+		for h := range c.unconfirmedRemovedSubscribers.GetHandlers(address) {
+			unconfirmedRemovedHandlers = append(unconfirmedRemovedHandlers, h)
+		}
+
+		empty, err := c.unconfirmedRemovedSubscribers.RemoveHandlers(address, unconfirmedRemovedHandlers...)
+		if err = c.unsubscribeIfEmpty(pathUnconfirmedRemoved, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	if c.partialAddedSubscribers.HasHandlers(address) {
+		partialAddedHandlers := make([]*subscribers.PartialAddedHandler, 0)
+		for h := range c.partialAddedSubscribers.GetHandlers(address) {
+			partialAddedHandlers = append(partialAddedHandlers, h)
+		}
+
+		empty, err := c.partialAddedSubscribers.RemoveHandlers(address, partialAddedHandlers...)
+		if err = c.unsubscribeIfEmpty(pathPartialAdded, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	if c.partialRemovedSubscribers.HasHandlers(address) {
+		partialRemovedHandlers := make([]*subscribers.PartialRemovedHandler, 0)
+		for h := range c.partialRemovedSubscribers.GetHandlers(address) {
+			partialRemovedHandlers = append(partialRemovedHandlers, h)
+		}
+
+		empty, err := c.partialRemovedSubscribers.RemoveHandlers(address, partialRemovedHandlers...)
+		if err = c.unsubscribeIfEmpty(pathPartialRemoved, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	if c.statusSubscribers.HasHandlers(address) {
+		statusHandlers := make([]*subscribers.StatusHandler, 0)
+		for h := range c.statusSubscribers.GetHandlers(address) {
+			statusHandlers = append(statusHandlers, h)
+		}
+
+		empty, err := c.statusSubscribers.RemoveHandlers(address, statusHandlers...)
+		if err = c.unsubscribeIfEmpty(pathStatus, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	if c.cosignatureSubscribers.HasHandlers(address) {
+		cosignatureHandlers := make([]*subscribers.CosignatureHandler, 0)
+		for h := range c.cosignatureSubscribers.GetHandlers(address) {
+			cosignatureHandlers = append(cosignatureHandlers, h)
+		}
+
+		empty, err := c.cosignatureSubscribers.RemoveHandlers(address, cosignatureHandlers...)
+		if err = c.unsubscribeIfEmpty(pathCosignature, address, empty, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishes unsubscribe message for topic of address, if removing of handlers succeeded and no handlers are left
+// it should be called under lock
+func (c *CatapultWebsocketClientImpl) unsubscribeIfEmpty(path Path, address *sdk.Address, empty bool, err error) error {
+	if err != nil {
+		return errors.Wrap(err, "removing handlers functions from handlers storage")
+	}
+
+	if !empty {
+		return nil
+	}
+
+	return c.publishUnsubscribeMessage(path, address)
+}
+
+func (c *CatapultWebsocketClientImpl) publishUnsubscribeMessage(path Path, address *sdk.Address) error {
+	if err := c.messagePublisher.PublishUnsubscribeMessage(c.UID, Path(fmt.Sprintf("%s/%s", path, address.Address))); err != nil {
+		return errors.Wrap(err, "publishing unsubscribe message into websocket")
+	}
+
+	return nil
+}
+
 func (c *CatapultWebsocketClientImpl) reconnect() error {
+	if err := c.resubscribe(); err != nil {
+		return err
+	}
+
+	// live messages are not read until missed ones are delivered
+	if c.backfill != nil {
+		if err := c.fillGap(c.ctx); err != nil {
+			c.backfill.reportError(errors.Wrap(err, "fetching messages missed while websocket was disconnected"))
+		}
+	}
+
+	return nil
+}
+
+// connects to node and subscribes all topics with handlers
+func (c *CatapultWebsocketClientImpl) resubscribe() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
 
 	conn, uid, err := c.connectFn(c.config)
 	if err != nil {
@@ -441,17 +909,52 @@ func (c *CatapultWebsocketClientImpl) reconnect() error {
 	return nil
 }
 
-func (c *CatapultWebsocketClientImpl) Close() error {
-	if c.conn != nil {
-		if err := c.conn.Close(); err != nil {
-			return err
-		}
+func (c *CatapultWebsocketClientImpl) newBlockTopicHandler() *TopicHandler {
+	var handler hdlrs.Handler = hdlrs.NewBlockHandler(sdk.BlockMapperFn(sdk.MapBlock), c.blockSubscriber)
 
-		c.conn = nil
+	if c.backfill != nil {
+		handler = &backfillBlockHandler{Handler: handler, backfill: c.backfill}
 	}
 
+	return &TopicHandler{
+		Handler: handler,
+		Topic:   topicFormatFn(formatBlockTopic),
+	}
+}
+
+func (c *CatapultWebsocketClientImpl) newConfirmedAddedTopicHandler() *TopicHandler {
+	mapper := sdk.NewConfirmedAddedMapper(sdk.MapTransaction)
+
+	var handler hdlrs.Handler = hdlrs.NewConfirmedAddedHandler(mapper, c.confirmedAddedSubscribers)
+
+	if c.backfill != nil {
+		handler = &backfillConfirmedAddedHandler{Handler: handler, backfill: c.backfill, messageMapper: mapper}
+	}
+
+	return &TopicHandler{
+		Handler: handler,
+		Topic:   topicFormatFn(formatPlainTopic),
+	}
+}
+
+func (c *CatapultWebsocketClientImpl) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+
+	conn := c.conn
+	c.conn = nil
+
+	// context is cancelled first, so reading goroutine doesn't treat closed connection as broken one
 	c.cancelFunc()
 
+	if conn != nil {
+		if err := conn.Close(); err != nil {
+			return err
+		}
+	}
+
 	c.alreadyListening = false
 
 	c.blockSubscriber = nil
@@ -469,10 +972,48 @@ func (c *CatapultWebsocketClientImpl) Close() error {
 }
 
 func connect(cfg *sdk.Config) (*websocket.Conn, string, error) {
+	return connectWithDialer(cfg, nil)
+}
+
+// returns websocket.Dialer configured by config's Transport, websocket.DefaultDialer settings are used for the rest
+func NewDialer(cfg *sdk.Config) *websocket.Dialer {
+	dialer := *websocket.DefaultDialer
+
+	if t := cfg.Transport; t != nil {
+		if t.TLSConfig != nil {
+			dialer.TLSClientConfig = t.TLSConfig
+		}
+
+		if t.Proxy != nil {
+			dialer.Proxy = t.Proxy
+		}
+
+		if t.DialContext != nil {
+			dialer.NetDialContext = t.DialContext
+		}
+
+		if t.HandshakeTimeout != 0 {
+			dialer.HandshakeTimeout = t.HandshakeTimeout
+		}
+	}
+
+	return &dialer
+}
+
+func connectWithDialer(cfg *sdk.Config, dialer *websocket.Dialer) (*websocket.Conn, string, error) {
 	var conn *websocket.Conn
 	var err error
 
-	conn, _, err = websocket.DefaultDialer.Dial(convertToWsUrl(cfg.UsedBaseUrl).String(), nil)
+	if dialer == nil {
+		dialer = NewDialer(cfg)
+	}
+
+	var header http.Header
+	if cfg.Transport != nil {
+		header = cfg.Transport.Header
+	}
+
+	conn, _, err = dialer.Dial(convertToWsUrl(cfg.UsedBaseUrl).String(), header)
 	if err != nil {
 		for _, u := range cfg.BaseURLs {
 
@@ -480,7 +1021,7 @@ func connect(cfg *sdk.Config) (*websocket.Conn, string, error) {
 				continue
 			}
 
-			conn, _, err = websocket.DefaultDialer.Dial(convertToWsUrl(u).String(), nil)
+			conn, _, err = dialer.Dial(convertToWsUrl(u).String(), header)
 			if err != nil {
 				continue
 			}
@@ -502,9 +1043,13 @@ func connect(cfg *sdk.Config) (*websocket.Conn, string, error) {
 	return conn, resp.Uid, nil
 }
 
+// returns url of websocket endpoint, secure websocket is used for https base url
 func convertToWsUrl(url *url.URL) *url.URL {
 	copyUrl := *url
-	copyUrl.Scheme = "ws" // always ws
+	copyUrl.Scheme = "ws"
+	if url.Scheme == "https" {
+		copyUrl.Scheme = "wss"
+	}
 	copyUrl.Path = pathWS
 	return &copyUrl
 }
@@ -7,6 +7,10 @@ package websocket
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
 
 	"github.com/gorilla/websocket"
@@ -1225,3 +1229,247 @@ func TestCatapultWebsocketClientImpl_reconnect(t *testing.T) {
 		})
 	}
 }
+
+func TestCatapultWebsocketClientImpl_RemoveConfirmedAddedHandlers(t *testing.T) {
+	uid := "123456"
+	address := &sdk.Address{Address: "test-address"}
+	topic := Path(fmt.Sprintf("%s/%s", pathConfirmedAdded, address.Address))
+
+	handlers := []subscribers.ConfirmedAddedHandler{
+		func(_ sdk.Transaction) bool { return false },
+		func(_ sdk.Transaction) bool { return false },
+	}
+
+	messagePublisher := new(MockMessagePublisher)
+	messagePublisher.On("PublishUnsubscribeMessage", uid, topic).Return(nil).Once()
+
+	c := &CatapultWebsocketClientImpl{
+		UID:                       uid,
+		confirmedAddedSubscribers: subscribers.NewConfirmedAdded(),
+		messagePublisher:          messagePublisher,
+	}
+
+	assert.Nil(t, c.confirmedAddedSubscribers.AddHandlers(address, handlers...))
+
+	assert.Nil(t, c.RemoveConfirmedAddedHandlers(address, &handlers[0]))
+	assert.True(t, c.confirmedAddedSubscribers.HasHandlers(address))
+	messagePublisher.AssertNotCalled(t, "PublishUnsubscribeMessage", uid, topic)
+
+	assert.Nil(t, c.RemoveConfirmedAddedHandlers(address, &handlers[1]))
+	assert.False(t, c.confirmedAddedSubscribers.HasHandlers(address))
+	assert.Empty(t, c.confirmedAddedSubscribers.GetAddresses())
+	messagePublisher.AssertExpectations(t)
+
+	assert.NotNil(t, c.RemoveConfirmedAddedHandlers(address, &handlers[1]))
+}
+
+func TestCatapultWebsocketClientImpl_UnsubscribeAddress(t *testing.T) {
+	uid := "123456"
+	address := &sdk.Address{Address: "test-address"}
+
+	messagePublisher := new(MockMessagePublisher)
+	messagePublisher.
+		On("PublishUnsubscribeMessage", uid, Path(fmt.Sprintf("%s/%s", pathConfirmedAdded, address.Address))).Return(nil).Once().
+		On("PublishUnsubscribeMessage", uid, Path(fmt.Sprintf("%s/%s", pathStatus, address.Address))).Return(nil).Once()
+
+	c := &CatapultWebsocketClientImpl{
+		UID:                           uid,
+		confirmedAddedSubscribers:     subscribers.NewConfirmedAdded(),
+		unconfirmedAddedSubscribers:   subscribers.NewUnconfirmedAdded(),
+		unconfirmedRemovedSubscribers: subscribers.NewUnconfirmedRemoved(),
+		partialAddedSubscribers:       subscribers.NewPartialAdded(),
+		partialRemovedSubscribers:     subscribers.NewPartialRemoved(),
+		statusSubscribers:             subscribers.NewStatus(),
+		cosignatureSubscribers:        subscribers.NewCosignature(),
+		messagePublisher:              messagePublisher,
+	}
+
+	assert.Nil(t, c.confirmedAddedSubscribers.AddHandlers(address, func(_ sdk.Transaction) bool { return false }))
+	assert.Nil(t, c.statusSubscribers.AddHandlers(address, func(_ *sdk.StatusInfo) bool { return false }))
+
+	assert.Nil(t, c.UnsubscribeAddress(address))
+	assert.False(t, c.confirmedAddedSubscribers.HasHandlers(address))
+	assert.False(t, c.statusSubscribers.HasHandlers(address))
+	messagePublisher.AssertExpectations(t)
+
+	assert.Equal(t, sdk.ErrNilAddress, c.UnsubscribeAddress(nil))
+}
+
+func TestCatapultWebsocketClientImpl_UnsubscribeAddressConcurrentClose(t *testing.T) {
+	address := &sdk.Address{Address: "test-address"}
+
+	for i := 0; i < 50; i++ {
+		messagePublisher := new(MockMessagePublisher)
+		messagePublisher.On("PublishUnsubscribeMessage", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		c := &CatapultWebsocketClientImpl{
+			ctx:                           ctx,
+			cancelFunc:                    cancel,
+			confirmedAddedSubscribers:     subscribers.NewConfirmedAdded(),
+			unconfirmedAddedSubscribers:   subscribers.NewUnconfirmedAdded(),
+			unconfirmedRemovedSubscribers: subscribers.NewUnconfirmedRemoved(),
+			partialAddedSubscribers:       subscribers.NewPartialAdded(),
+			partialRemovedSubscribers:     subscribers.NewPartialRemoved(),
+			statusSubscribers:             subscribers.NewStatus(),
+			cosignatureSubscribers:        subscribers.NewCosignature(),
+			messagePublisher:              messagePublisher,
+		}
+
+		assert.Nil(t, c.statusSubscribers.AddHandlers(address, func(_ *sdk.StatusInfo) bool { return false }))
+		assert.Nil(t, c.cosignatureSubscribers.AddHandlers(address, func(_ *sdk.SignerInfo) bool { return false }))
+
+		done := make(chan error)
+		go func() {
+			done <- c.UnsubscribeAddress(address)
+		}()
+
+		assert.Nil(t, c.Close())
+
+		if err := <-done; err != nil {
+			assert.Equal(t, ErrClientClosed, err)
+		}
+	}
+}
+
+func TestConvertToWsUrl(t *testing.T) {
+	tests := []struct {
+		baseUrl  string
+		expected string
+	}{
+		{"http://localhost:3000", "ws://localhost:3000/ws"},
+		{"https://gateway.example.com", "wss://gateway.example.com/ws"},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.baseUrl)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.expected, convertToWsUrl(u).String())
+	}
+}
+
+func TestNewClient_Transport(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.WriteJSON(&wsConnectionResponse{Uid: "test-uid"})
+	}))
+	defer server.Close()
+
+	baseUrl, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+
+	cfg := &sdk.Config{BaseURLs: []*url.URL{baseUrl}, UsedBaseUrl: baseUrl}
+
+	_, err = NewClient(context.Background(), cfg)
+	assert.NotNil(t, err)
+
+	cfg.Transport = &sdk.TransportConfig{
+		TLSConfig: server.Client().Transport.(*http.Transport).TLSClientConfig,
+		Header:    http.Header{"Authorization": []string{"Bearer token"}},
+	}
+
+	client, err := NewClient(context.Background(), cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, "test-uid", client.(*CatapultWebsocketClientImpl).UID)
+	assert.Nil(t, client.Close())
+
+	// custom dialer without TLS configuration doesn't trust test server
+	_, err = NewClientWithDialer(context.Background(), cfg, websocket.DefaultDialer)
+	assert.NotNil(t, err)
+}
+
+func TestNewClient_CopiesConfig(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.WriteJSON(&wsConnectionResponse{Uid: "test-uid"})
+	}))
+	defer server.Close()
+
+	down, err := url.Parse("http://127.0.0.1:1")
+	assert.Nil(t, err)
+
+	up, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+
+	cfg := &sdk.Config{BaseURLs: []*url.URL{down, up}, UsedBaseUrl: down}
+
+	client, err := NewClient(context.Background(), cfg)
+	assert.Nil(t, err)
+	defer client.Close()
+
+	assert.Equal(t, up, client.(*CatapultWebsocketClientImpl).config.UsedBaseUrl)
+	assert.Equal(t, down, cfg.UsedBaseUrl)
+
+	client.(*CatapultWebsocketClientImpl).rotateBaseUrl()
+	assert.Equal(t, down, cfg.UsedBaseUrl)
+}
+
+func TestCatapultWebsocketClientImpl_ConcurrentSubscriptions(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.WriteJSON(&wsConnectionResponse{Uid: "test-uid"})
+
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	baseUrl, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+
+	client, err := NewClient(context.Background(), &sdk.Config{BaseURLs: []*url.URL{baseUrl}, UsedBaseUrl: baseUrl})
+	assert.Nil(t, err)
+
+	go client.Listen()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			address := &sdk.Address{Address: fmt.Sprintf("address-%d", i%3)}
+			handlers := []subscribers.StatusHandler{func(*sdk.StatusInfo) bool { return false }}
+
+			assert.Nil(t, client.AddStatusHandlers(address, handlers...))
+			assert.Nil(t, client.AddBlockHandlers(func(*sdk.BlockInfo) bool { return false }))
+			assert.Nil(t, client.RemoveStatusHandlers(address, &handlers[0]))
+		}(i)
+	}
+
+	wg.Wait()
+
+	assert.Nil(t, client.Close())
+	assert.Equal(t, ErrClientClosed, client.AddBlockHandlers(func(*sdk.BlockInfo) bool { return false }))
+}
@@ -0,0 +1,186 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"hash/fnv"
+	"log"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+const (
+	DefaultDispatchWorkers   = 8
+	DefaultDispatchQueueSize = 256
+)
+
+// DispatchOptions configures delivery of received messages to handlers
+// messages of the same topic are handled sequentially in order of receiving by one of `Workers`
+// `QueueSize` limits number of messages waiting for every worker, `Policy` describes what to do with new message if queue is full,
+// BlockOnFull stops reading of websocket until there is a room in the queue
+// `PanicHandler` is called with *handlers.PanicError if handler panics, panic is not propagated anyway,
+// it is logged by standard logger if `PanicHandler` is nil
+type DispatchOptions struct {
+	Workers      int
+	QueueSize    int
+	Policy       OverflowPolicy
+	PanicHandler func(err error)
+}
+
+// returns options with defaults instead of zero values
+func (o *DispatchOptions) withDefaults() *DispatchOptions {
+	opts := DispatchOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultDispatchWorkers
+	}
+
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = DefaultDispatchQueueSize
+	}
+
+	return &opts
+}
+
+// dispatcher routes messages in worker pool, messages of the same topic are always routed by the same worker
+type dispatcher struct {
+	opts   *DispatchOptions
+	router Router
+	queues []chan dispatchItem
+}
+
+// dispatchItem is either message received from websocket or replay of message fetched over REST
+type dispatchItem struct {
+	msg    []byte
+	replay func()
+}
+
+func newDispatcher(router Router, opts *DispatchOptions) *dispatcher {
+	opts = opts.withDefaults()
+
+	queues := make([]chan dispatchItem, opts.Workers)
+	for i := range queues {
+		queues[i] = make(chan dispatchItem, opts.QueueSize)
+	}
+
+	return &dispatcher{
+		opts:   opts,
+		router: router,
+		queues: queues,
+	}
+}
+
+// starts workers, which are stopped when passed context is done
+func (d *dispatcher) start(ctx context.Context) {
+	for _, queue := range d.queues {
+		go func(queue chan dispatchItem) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case item := <-queue:
+					if item.replay != nil {
+						d.protect(item.replay)
+					} else {
+						d.route(item.msg)
+					}
+				}
+			}
+		}(queue)
+	}
+}
+
+// puts message into the queue of topic's worker according to overflow policy
+func (d *dispatcher) dispatch(ctx context.Context, msg []byte) {
+	queue := d.queues[d.worker(msg)]
+	item := dispatchItem{msg: msg}
+
+	switch d.opts.Policy {
+	case DropNewest:
+		select {
+		case queue <- item:
+		default:
+		}
+	case DropOldest:
+		for {
+			select {
+			case queue <- item:
+				return
+			default:
+			}
+
+			select {
+			case <-queue:
+			default:
+			}
+		}
+	default:
+		select {
+		case queue <- item:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// puts replay of message of passed topic into the queue of topic's worker, so it is handled after messages already queued for the topic
+// replay waits for room in the queue regardless of overflow policy, because it can't be received again
+func (d *dispatcher) replay(ctx context.Context, channelName string, address *sdk.Address, f func()) {
+	select {
+	case d.queues[d.topicWorker(channelName, address)] <- dispatchItem{replay: f}:
+	case <-ctx.Done():
+	}
+}
+
+// returns index of worker for topic of passed message
+// messages with unknown topic are routed by the first worker, router reports them anyway
+func (d *dispatcher) worker(msg []byte) int {
+	info, err := MapMessageInfo(msg)
+	if err != nil {
+		return 0
+	}
+
+	return d.topicWorker(info.ChannelName, info.Address)
+}
+
+func (d *dispatcher) topicWorker(channelName string, address *sdk.Address) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(channelName))
+
+	if address != nil {
+		_, _ = h.Write([]byte(address.Address))
+	}
+
+	return int(h.Sum32() % uint32(len(d.queues)))
+}
+
+func (d *dispatcher) route(msg []byte) {
+	d.protect(func() {
+		d.router.RouteMessage(msg)
+	})
+}
+
+// calls passed function and recovers its panic, which is passed to PanicHandler or logged
+func (d *dispatcher) protect(f func()) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		err := toPanicError(r)
+
+		if d.opts.PanicHandler != nil {
+			d.opts.PanicHandler(err)
+		} else {
+			log.Printf("websocket handler panicked: %v", err)
+		}
+	}()
+
+	f()
+}
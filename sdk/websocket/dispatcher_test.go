@@ -0,0 +1,199 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	hdlrs "github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/handlers"
+)
+
+type testRouter struct {
+	sync.Mutex
+	wg       sync.WaitGroup
+	received map[string][]int
+}
+
+type testMessage struct {
+	Meta struct {
+		ChannelName string `json:"channelName"`
+		Address     string `json:"address,omitempty"`
+	} `json:"meta"`
+	Seq int `json:"seq"`
+}
+
+func newTestMessage(channelName, address string, seq int) []byte {
+	msg := testMessage{Seq: seq}
+	msg.Meta.ChannelName = channelName
+	msg.Meta.Address = address
+
+	b, _ := json.Marshal(&msg)
+	return b
+}
+
+func (r *testRouter) RouteMessage(m []byte) {
+	defer r.wg.Done()
+
+	msg := testMessage{}
+	_ = json.Unmarshal(m, &msg)
+
+	if msg.Seq < 0 {
+		panic("test panic")
+	}
+
+	// later messages are handled faster, so order is broken without per topic ordering
+	time.Sleep(time.Duration(10-msg.Seq%10) * 100 * time.Microsecond)
+
+	r.Lock()
+	defer r.Unlock()
+
+	topic := msg.Meta.ChannelName + msg.Meta.Address
+	r.received[topic] = append(r.received[topic], msg.Seq)
+}
+
+func (r *testRouter) SetUid(string) {}
+
+func TestDispatcher_PerTopicOrdering(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := &testRouter{received: make(map[string][]int)}
+	d := newDispatcher(router, &DispatchOptions{Workers: 4})
+	d.start(ctx)
+
+	topics := []struct {
+		channelName string
+		address     string
+	}{
+		{"block", ""},
+		{"status", "901CD938C5CE4ED22031C5CE398E618EB1205D5344E2539B58"},
+		{"status", "90FD35818960C7B18B72F49A5598FA9F712A354DB38EB076C4"},
+		{"confirmedAdded", "90FD35818960C7B18B72F49A5598FA9F712A354DB38EB076C4"},
+	}
+
+	expected := make([]int, 0)
+	for seq := 0; seq < 30; seq++ {
+		expected = append(expected, seq)
+
+		for _, topic := range topics {
+			router.wg.Add(1)
+			d.dispatch(ctx, newTestMessage(topic.channelName, topic.address, seq))
+		}
+	}
+
+	router.wg.Wait()
+
+	for _, topic := range topics {
+		assert.Equal(t, expected, router.received[topic.channelName+topic.address], "%s/%s", topic.channelName, topic.address)
+	}
+}
+
+func TestDispatcher_PanicHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	panics := make(chan error, 1)
+
+	router := &testRouter{received: make(map[string][]int)}
+	d := newDispatcher(router, &DispatchOptions{
+		PanicHandler: func(err error) {
+			panics <- err
+		},
+	})
+	d.start(ctx)
+
+	router.wg.Add(2)
+	d.dispatch(ctx, newTestMessage("block", "", -1))
+	d.dispatch(ctx, newTestMessage("block", "", 1))
+	router.wg.Wait()
+
+	select {
+	case err := <-panics:
+		assert.Equal(t, &hdlrs.PanicError{Value: "test panic"}, err)
+	case <-time.After(time.Second):
+		t.Fatal("panic is not reported")
+	}
+
+	// worker keeps working after panic
+	assert.Equal(t, []int{1}, router.received["block"])
+}
+
+func TestDispatcher_replay(t *testing.T) {
+	ctx := context.Background()
+	// address is encoded in hex in messages
+	encoded := "901CD938C5CE4ED22031C5CE398E618EB1205D5344E2539B58"
+	address, err := sdk.NewAddressFromBase32(encoded)
+	assert.Nil(t, err)
+
+	// workers are not started, so the queue is not drained
+	d := newDispatcher(&testRouter{}, &DispatchOptions{Workers: 4})
+
+	live := newTestMessage("confirmedAdded", encoded, 1)
+	d.dispatch(ctx, live)
+
+	replayed := false
+	d.replay(ctx, "confirmedAdded", address, func() { replayed = true })
+
+	// replay is queued for the same worker after live message of the topic
+	queue := d.queues[d.worker(live)]
+	if !assert.Len(t, queue, 2) {
+		return
+	}
+	assert.Equal(t, live, (<-queue).msg)
+
+	item := <-queue
+	assert.NotNil(t, item.replay)
+	item.replay()
+	assert.True(t, replayed)
+}
+
+func TestDispatcher_protectLogsPanic(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	d := newDispatcher(&testRouter{received: make(map[string][]int)}, nil)
+	d.protect(func() {
+		panic("test panic")
+	})
+
+	assert.Contains(t, buf.String(), "test panic")
+}
+
+func TestDispatcher_OverflowPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		policy   OverflowPolicy
+		expected int
+	}{
+		{DropNewest, 0},
+		{DropOldest, 2},
+	}
+
+	for _, tt := range tests {
+		// workers are not started, so the queue is not drained
+		d := newDispatcher(&testRouter{}, &DispatchOptions{Workers: 1, QueueSize: 1, Policy: tt.policy})
+
+		for seq := 0; seq < 3; seq++ {
+			d.dispatch(ctx, newTestMessage("block", "", seq))
+		}
+
+		msg := testMessage{}
+		assert.Nil(t, json.Unmarshal((<-d.queues[0]).msg, &msg))
+		assert.Equal(t, tt.expected, msg.Seq, fmt.Sprintf("policy %d", tt.policy))
+	}
+}
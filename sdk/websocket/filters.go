@@ -0,0 +1,138 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+// TransactionFilter reports whether transaction should be passed to handlers
+type TransactionFilter func(tx sdk.Transaction) bool
+
+// TransactionMiddleware wraps handler of transactions, e.g. subscribers.ConfirmedAddedHandler or subscribers.UnconfirmedAddedHandler
+type TransactionMiddleware func(next func(sdk.Transaction) bool) func(sdk.Transaction) bool
+
+// returns handler wrapped by passed middlewares, the first middleware is the outermost one
+// result can be passed to AddConfirmedAddedHandlers and AddUnconfirmedAddedHandlers
+func WrapTransactionHandler(handler func(sdk.Transaction) bool, middlewares ...TransactionMiddleware) func(sdk.Transaction) bool {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	return handler
+}
+
+// returns middleware, which passes to handler only transactions matching all filters
+// handler is kept while transactions are skipped
+func Filter(filters ...TransactionFilter) TransactionMiddleware {
+	filter := AllOf(filters...)
+
+	return func(next func(sdk.Transaction) bool) func(sdk.Transaction) bool {
+		return func(tx sdk.Transaction) bool {
+			if !filter(tx) {
+				return false
+			}
+
+			return next(tx)
+		}
+	}
+}
+
+// returns middleware, which replaces secure message of TransferTransaction sent to passed account by decrypted plain message
+// handler gets a copy of transaction, transactions, which can't be decrypted, are passed as is
+func DecryptMessages(account *sdk.Account) TransactionMiddleware {
+	return func(next func(sdk.Transaction) bool) func(sdk.Transaction) bool {
+		return func(tx sdk.Transaction) bool {
+			if transfer, ok := tx.(*sdk.TransferTransaction); ok {
+				if decrypted := decryptTransfer(account, transfer); decrypted != nil {
+					tx = decrypted
+				}
+			}
+
+			return next(tx)
+		}
+	}
+}
+
+// returns nil if message of transfer is not secure or is not sent to passed account
+func decryptTransfer(account *sdk.Account, transfer *sdk.TransferTransaction) *sdk.TransferTransaction {
+	secure, ok := transfer.Message.(*sdk.SecureMessage)
+	if !ok || transfer.Signer == nil {
+		return nil
+	}
+
+	if transfer.Recipient == nil || transfer.Recipient.Address != account.Address.Address {
+		return nil
+	}
+
+	message, err := account.DecryptMessage(secure, transfer.Signer)
+	if err != nil {
+		return nil
+	}
+
+	decrypted := *transfer
+	decrypted.Message = message
+
+	return &decrypted
+}
+
+// returns filter matching transactions matched by all passed filters
+func AllOf(filters ...TransactionFilter) TransactionFilter {
+	return func(tx sdk.Transaction) bool {
+		for _, filter := range filters {
+			if !filter(tx) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// returns filter matching transactions matched by any of passed filters
+func AnyOf(filters ...TransactionFilter) TransactionFilter {
+	return func(tx sdk.Transaction) bool {
+		for _, filter := range filters {
+			if filter(tx) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// returns filter matching transactions of passed types
+func TransactionTypes(types ...sdk.EntityType) TransactionFilter {
+	return func(tx sdk.Transaction) bool {
+		txType := tx.GetAbstractTransaction().Type
+
+		for _, t := range types {
+			if txType == t {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// returns filter matching TransferTransaction's carrying at least `minAmount` of mosaic with passed AssetId
+func TransferOfMosaic(assetId sdk.AssetId, minAmount sdk.Amount) TransactionFilter {
+	return func(tx sdk.Transaction) bool {
+		transfer, ok := tx.(*sdk.TransferTransaction)
+		if !ok {
+			return false
+		}
+
+		for _, mosaic := range transfer.Mosaics {
+			if mosaic.AssetId.Equals(assetId) && mosaic.Amount >= minAmount {
+				return true
+			}
+		}
+
+		return false
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+func newTestTransfer(mosaics ...*sdk.Mosaic) *sdk.TransferTransaction {
+	return &sdk.TransferTransaction{
+		AbstractTransaction: sdk.AbstractTransaction{Type: sdk.Transfer},
+		Mosaics:             mosaics,
+	}
+}
+
+func TestTransferOfMosaic(t *testing.T) {
+	mosaicId, err := sdk.NewMosaicId(0x1234)
+	assert.Nil(t, err)
+
+	other, err := sdk.NewMosaic(mosaicId, 1000)
+	assert.Nil(t, err)
+
+	filter := TransferOfMosaic(sdk.XpxNamespaceId, 100)
+
+	assert.True(t, filter(newTestTransfer(other, sdk.Xpx(100))))
+	assert.False(t, filter(newTestTransfer(sdk.Xpx(99))))
+	assert.False(t, filter(newTestTransfer(other)))
+	assert.False(t, filter(&sdk.AggregateTransaction{AbstractTransaction: sdk.AbstractTransaction{Type: sdk.AggregateCompleted}}))
+}
+
+func TestFilter(t *testing.T) {
+	received := make([]sdk.Transaction, 0)
+
+	handler := WrapTransactionHandler(func(tx sdk.Transaction) bool {
+		received = append(received, tx)
+		return false
+	}, Filter(
+		TransactionTypes(sdk.Transfer, sdk.AggregateCompleted),
+		AnyOf(TransferOfMosaic(sdk.XpxNamespaceId, 10), TransactionTypes(sdk.AggregateCompleted)),
+	))
+
+	matched := newTestTransfer(sdk.Xpx(10))
+	aggregate := &sdk.AggregateTransaction{AbstractTransaction: sdk.AbstractTransaction{Type: sdk.AggregateCompleted}}
+
+	assert.False(t, handler(newTestTransfer(sdk.Xpx(1))))
+	assert.False(t, handler(matched))
+	assert.False(t, handler(&sdk.AggregateTransaction{AbstractTransaction: sdk.AbstractTransaction{Type: sdk.AggregateBonded}}))
+	assert.False(t, handler(aggregate))
+
+	assert.Equal(t, []sdk.Transaction{matched, aggregate}, received)
+}
+
+func TestDecryptMessages(t *testing.T) {
+	sender, err := sdk.NewAccount(sdk.PublicTest, &sdk.Hash{})
+	assert.Nil(t, err)
+
+	recipient, err := sdk.NewAccount(sdk.PublicTest, &sdk.Hash{})
+	assert.Nil(t, err)
+
+	message, err := sender.EncryptMessage("secret", recipient.PublicAccount)
+	assert.Nil(t, err)
+
+	tx := newTestTransfer()
+	tx.Signer = sender.PublicAccount
+	tx.Recipient = recipient.Address
+	tx.Message = message
+
+	var received sdk.Transaction
+	handler := WrapTransactionHandler(func(tx sdk.Transaction) bool {
+		received = tx
+		return false
+	}, DecryptMessages(recipient))
+
+	handler(tx)
+	assert.Equal(t, sdk.NewPlainMessage("secret"), received.(*sdk.TransferTransaction).Message)
+	// original transaction is not changed
+	assert.Equal(t, message, tx.Message)
+
+	// message sent to another account is passed as is
+	tx.Recipient = sender.Address
+	handler(tx)
+	assert.Equal(t, tx, received)
+}
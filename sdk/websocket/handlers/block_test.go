@@ -85,3 +85,21 @@ func Test_blockHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func Test_blockHandler_HandlePanic(t *testing.T) {
+	messageMapperMock := new(mappers.BlockMapper)
+	messageMapperMock.On("MapBlock", mock.Anything).Return(new(sdk.BlockInfo), nil)
+
+	handlers := subscribers.NewBlock()
+	assert.Nil(t, handlers.AddHandlers(
+		func(*sdk.BlockInfo) bool { panic("test panic") },
+		func(*sdk.BlockInfo) bool { return false },
+	))
+
+	// panic of handler goroutine is rethrown in the calling one
+	defer func() {
+		assert.Equal(t, &PanicError{Value: "test panic"}, recover())
+	}()
+
+	NewBlockHandler(messageMapperMock, handlers).Handle(nil, nil)
+}
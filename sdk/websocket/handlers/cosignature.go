@@ -36,12 +36,16 @@ func (h *cosignatureHandler) Handle(address *sdk.Address, resp []byte) bool {
 		return true
 	}
 
-	var wg sync.WaitGroup
+	var (
+		wg     sync.WaitGroup
+		panics handlerPanics
+	)
 
 	for f := range handlers {
 		wg.Add(1)
 		go func(f *subscribers.CosignatureHandler) {
 			defer wg.Done()
+			defer panics.recover()
 
 			callFunc := *f
 			if rm := callFunc(res); !rm {
@@ -56,6 +60,7 @@ func (h *cosignatureHandler) Handle(address *sdk.Address, resp []byte) bool {
 	}
 
 	wg.Wait()
+	panics.rethrow()
 
 	return h.handlers.HasHandlers(address)
 }
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"fmt"
+	"sync"
+)
+
+// PanicError describes panic, which is recovered during handling of message
+type PanicError struct {
+	Value interface{}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("handler panicked: %v", e.Value)
+}
+
+// handlerPanics collects panics of handlers called in separate goroutines, so they can be rethrown in the calling one
+type handlerPanics struct {
+	sync.Mutex
+	err *PanicError
+}
+
+// should be deferred directly in handler goroutine
+func (p *handlerPanics) recover() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.err == nil {
+		p.err = &PanicError{Value: r}
+	}
+}
+
+// panics with the first collected PanicError if any
+func (p *handlerPanics) rethrow() {
+	if p.err != nil {
+		panic(p.err)
+	}
+}
@@ -32,12 +32,16 @@ func (h *statusHandler) Handle(address *sdk.Address, resp []byte) bool {
 		return true
 	}
 
-	var wg sync.WaitGroup
+	var (
+		wg     sync.WaitGroup
+		panics handlerPanics
+	)
 
 	for f := range handlers {
 		wg.Add(1)
 		go func(f *subscribers.StatusHandler) {
 			defer wg.Done()
+			defer panics.recover()
 
 			callFunc := *f
 
@@ -55,6 +59,7 @@ func (h *statusHandler) Handle(address *sdk.Address, resp []byte) bool {
 	}
 
 	wg.Wait()
+	panics.rethrow()
 
 	return h.handlers.HasHandlers(address)
 }
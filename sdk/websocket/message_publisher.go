@@ -57,6 +57,9 @@ func (p *catapultWebsocketMessagePublisher) PublishUnsubscribeMessage(uid string
 }
 
 func (p *catapultWebsocketMessagePublisher) SetConn(conn *websocket.Conn) {
+	p.Lock()
+	defer p.Unlock()
+
 	p.conn = conn
 }
 
@@ -0,0 +1,144 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	hdlrs "github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/handlers"
+)
+
+// HandlerFunc adapts function to handlers.Handler, result reports whether topic is still necessary
+type HandlerFunc func(address *sdk.Address, resp []byte) bool
+
+func (f HandlerFunc) Handle(address *sdk.Address, resp []byte) bool {
+	return f(address, resp)
+}
+
+// Middleware wraps handler of raw messages of topic with passed path
+// it is called for every message before it is mapped, so it should be cheap
+type Middleware func(path Path, next hdlrs.Handler) hdlrs.Handler
+
+// Logger is satisfied by *log.Logger
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// returns middleware, which logs every message with its topic and address
+func Logging(logger Logger) Middleware {
+	return func(path Path, next hdlrs.Handler) hdlrs.Handler {
+		return HandlerFunc(func(address *sdk.Address, resp []byte) bool {
+			if address != nil {
+				logger.Printf("websocket message: topic %s, address %s, %d bytes", path, address.Address, len(resp))
+			} else {
+				logger.Printf("websocket message: topic %s, %d bytes", path, len(resp))
+			}
+
+			return next.Handle(address, resp)
+		})
+	}
+}
+
+// returns middleware, which passes to `observe` the time spent by handlers of every message
+// `err` is *handlers.PanicError if handlers panicked, panic is propagated anyway
+func Metrics(observe func(path Path, elapsed time.Duration, err error)) Middleware {
+	return func(path Path, next hdlrs.Handler) hdlrs.Handler {
+		return HandlerFunc(func(address *sdk.Address, resp []byte) bool {
+			start := time.Now()
+
+			defer func() {
+				if r := recover(); r != nil {
+					observe(path, time.Since(start), toPanicError(r))
+					panic(r)
+				}
+
+				observe(path, time.Since(start), nil)
+			}()
+
+			return next.Handle(address, resp)
+		})
+	}
+}
+
+// returns middleware, which recovers panics of handlers and passes them to `onPanic` as *handlers.PanicError
+// topic is kept subscribed after panic
+func Recovery(onPanic func(path Path, err error)) Middleware {
+	return func(path Path, next hdlrs.Handler) hdlrs.Handler {
+		return HandlerFunc(func(address *sdk.Address, resp []byte) (ok bool) {
+			defer func() {
+				if r := recover(); r != nil {
+					ok = true
+
+					if onPanic != nil {
+						onPanic(path, toPanicError(r))
+					}
+				}
+			}()
+
+			return next.Handle(address, resp)
+		})
+	}
+}
+
+func toPanicError(r interface{}) *hdlrs.PanicError {
+	if err, ok := r.(*hdlrs.PanicError); ok {
+		return err
+	}
+
+	return &hdlrs.PanicError{Value: r}
+}
+
+// adds middlewares, which wrap handlers of all topics, the first middleware is the outermost one
+// middlewares are applied to messages received after the call
+func (c *CatapultWebsocketClientImpl) Use(middlewares ...Middleware) {
+	c.middlewares.add(middlewares...)
+}
+
+type middlewareChain struct {
+	sync.RWMutex
+	middlewares []Middleware
+}
+
+func (m *middlewareChain) add(middlewares ...Middleware) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.middlewares = append(m.middlewares, middlewares...)
+}
+
+func (m *middlewareChain) wrap(path Path, handler hdlrs.Handler) hdlrs.Handler {
+	m.RLock()
+	defer m.RUnlock()
+
+	for i := len(m.middlewares) - 1; i >= 0; i-- {
+		handler = m.middlewares[i](path, handler)
+	}
+
+	return handler
+}
+
+// middlewareTopicHandlers wraps handlers of storage by middlewares of chain, when they are got for routing
+type middlewareTopicHandlers struct {
+	TopicHandlersStorage
+	chain *middlewareChain
+}
+
+func withMiddlewares(storage TopicHandlersStorage, chain *middlewareChain) TopicHandlersStorage {
+	return &middlewareTopicHandlers{TopicHandlersStorage: storage, chain: chain}
+}
+
+func (h *middlewareTopicHandlers) GetHandler(path Path) *TopicHandler {
+	handler := h.TopicHandlersStorage.GetHandler(path)
+	if handler == nil {
+		return nil
+	}
+
+	return &TopicHandler{
+		Topic:   handler.Topic,
+		Handler: h.chain.wrap(path, handler.Handler),
+	}
+}
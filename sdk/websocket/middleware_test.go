@@ -0,0 +1,83 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	hdlrs "github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/handlers"
+)
+
+type testLogger []string
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	*l = append(*l, format)
+}
+
+func TestCatapultWebsocketClientImpl_Use(t *testing.T) {
+	storage := make(topicHandlers)
+	c := &CatapultWebsocketClientImpl{topicHandlers: storage}
+	routed := withMiddlewares(storage, &c.middlewares)
+
+	calls := make([]string, 0)
+	trace := func(name string) Middleware {
+		return func(path Path, next hdlrs.Handler) hdlrs.Handler {
+			return HandlerFunc(func(address *sdk.Address, resp []byte) bool {
+				calls = append(calls, name+" "+string(path))
+				return next.Handle(address, resp)
+			})
+		}
+	}
+
+	storage.SetTopicHandler(pathBlock, &TopicHandler{
+		Topic: topicFormatFn(formatBlockTopic),
+		Handler: HandlerFunc(func(*sdk.Address, []byte) bool {
+			calls = append(calls, "handler")
+			return true
+		}),
+	})
+
+	var (
+		logger  testLogger
+		elapsed []time.Duration
+	)
+
+	c.Use(trace("first"), trace("second"), Logging(&logger))
+	c.Use(Metrics(func(path Path, d time.Duration, err error) {
+		assert.Equal(t, pathBlock, path)
+		assert.Nil(t, err)
+		elapsed = append(elapsed, d)
+	}))
+
+	assert.True(t, routed.GetHandler(pathBlock).Handle(nil, []byte("{}")))
+	assert.Equal(t, []string{"first block", "second block", "handler"}, calls)
+	assert.Len(t, logger, 1)
+	assert.Len(t, elapsed, 1)
+
+	// storage of client is not affected by middlewares
+	calls = calls[:0]
+	assert.True(t, storage.GetHandler(pathBlock).Handle(nil, nil))
+	assert.Equal(t, []string{"handler"}, calls)
+
+	assert.Nil(t, routed.GetHandler(pathConfirmedAdded))
+}
+
+func TestRecovery(t *testing.T) {
+	var recovered error
+
+	handler := Recovery(func(path Path, err error) {
+		assert.Equal(t, pathBlock, path)
+		recovered = err
+	})(pathBlock, HandlerFunc(func(*sdk.Address, []byte) bool {
+		panic("test panic")
+	}))
+
+	assert.True(t, handler.Handle(nil, nil))
+	assert.Equal(t, &hdlrs.PanicError{Value: "test panic"}, recovered)
+}
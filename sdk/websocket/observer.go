@@ -0,0 +1,43 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"time"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	hdlrs "github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/handlers"
+)
+
+// Observer receives events of websocket client, methods are called synchronously, so they should not block
+type Observer interface {
+	// called after handlers of message are done, `elapsed` is the time spent by handlers
+	ObserveMessage(path Path, size int, elapsed time.Duration)
+	// called when connection state is changed, see StateHandler
+	ObserveConnectionState(state ConnectionState, err error)
+}
+
+// sets observer of connection state and received messages, it should be called before Listen
+// messages are observed by middleware, which is added after already used ones
+func (c *CatapultWebsocketClientImpl) SetObserver(o Observer) {
+	c.mu.Lock()
+	c.observer = o
+	c.mu.Unlock()
+
+	c.Use(observingMiddleware(o))
+}
+
+func observingMiddleware(o Observer) Middleware {
+	return func(path Path, next hdlrs.Handler) hdlrs.Handler {
+		return HandlerFunc(func(address *sdk.Address, resp []byte) bool {
+			start := time.Now()
+			defer func() {
+				o.ObserveMessage(path, len(resp), time.Since(start))
+			}()
+
+			return next.Handle(address, resp)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+)
+
+const (
+	DefaultPingInterval = 10 * time.Second
+	// connection is considered stale if nothing is received during this number of expected block intervals
+	DefaultStaleBlocks   = 3
+	DefaultMaxBackoff    = time.Minute
+	DefaultBackoffJitter = 0.2
+)
+
+type ConnectionState uint8
+
+const (
+	Connected ConnectionState = iota
+	Reconnecting
+	Failed
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case Connected:
+		return "Connected"
+	case Reconnecting:
+		return "Reconnecting"
+	case Failed:
+		return "Failed"
+	}
+
+	return fmt.Sprintf("%d", s)
+}
+
+// StateHandler is called when connection state is changed, `err` is the cause of Reconnecting and Failed states
+type StateHandler func(state ConnectionState, err error)
+
+// ReconnectionOptions configures detection of broken connections and reconnection
+// `StaleTimeout` should cover a few block intervals, because block topic is the most frequent one,
+// it is DefaultStaleBlocks of `BlockGenerationTargetTime` by default
+// `BlockGenerationTargetTime` is requested from network config if zero, sdk.DefaultBlockGenerationTargetTime is used if it is not available
+// zero options are taken from config's Websocket, `InitialBackoff` is config's WsReconnectionTimeout by default, it is doubled after every failed attempt up to `MaxBackoff`
+// `Jitter` is the fraction of the backoff, which is randomly added or subtracted
+// `MaxAttempts` is unlimited if zero, client is closed after the last failed attempt
+type ReconnectionOptions struct {
+	PingInterval   time.Duration
+	StaleTimeout   time.Duration
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Jitter         float64
+	MaxAttempts    int
+	StateHandler   StateHandler
+
+	BlockGenerationTargetTime time.Duration
+
+	// stale timeout is derived from block generation target time
+	staleBlocks bool
+}
+
+// returns options with defaults instead of zero values
+func (o *ReconnectionOptions) withDefaults(cfg *sdk.Config) *ReconnectionOptions {
+	opts := ReconnectionOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	if cfg != nil && cfg.Websocket != nil {
+		opts.fillFrom(cfg.Websocket)
+	}
+
+	if opts.PingInterval == 0 {
+		opts.PingInterval = DefaultPingInterval
+	}
+
+	if opts.StaleTimeout == 0 {
+		opts.staleBlocks = true
+	}
+
+	if opts.staleBlocks {
+		blockTime := opts.BlockGenerationTargetTime
+		if blockTime == 0 {
+			blockTime = sdk.DefaultBlockGenerationTargetTime
+		}
+
+		opts.StaleTimeout = DefaultStaleBlocks * blockTime
+	}
+
+	if opts.InitialBackoff == 0 && cfg != nil {
+		opts.InitialBackoff = cfg.WsReconnectionTimeout
+	}
+
+	if opts.InitialBackoff == 0 {
+		opts.InitialBackoff = sdk.DefaultWebsocketReconnectionTimeout
+	}
+
+	if opts.MaxBackoff == 0 {
+		opts.MaxBackoff = DefaultMaxBackoff
+	}
+
+	if opts.MaxBackoff < opts.InitialBackoff {
+		opts.MaxBackoff = opts.InitialBackoff
+	}
+
+	if opts.Jitter == 0 {
+		opts.Jitter = DefaultBackoffJitter
+	}
+
+	return &opts
+}
+
+// fills zero options by values of passed config
+func (o *ReconnectionOptions) fillFrom(conf *sdk.WebsocketConfig) {
+	if o.PingInterval == 0 {
+		o.PingInterval = conf.PingInterval
+	}
+
+	if o.StaleTimeout == 0 {
+		o.StaleTimeout = conf.StaleTimeout
+	}
+
+	if o.MaxBackoff == 0 {
+		o.MaxBackoff = conf.MaxBackoff
+	}
+
+	if o.MaxAttempts == 0 {
+		o.MaxAttempts = conf.MaxAttempts
+	}
+}
+
+// returns delay before passed attempt of reconnection, attempts are counted from 1
+func (o *ReconnectionOptions) backoff(attempt int) time.Duration {
+	delay := float64(o.InitialBackoff) * math.Pow(2, float64(attempt-1))
+	if delay > float64(o.MaxBackoff) {
+		delay = float64(o.MaxBackoff)
+	}
+
+	delay += delay * o.Jitter * (2*rand.Float64() - 1)
+
+	return time.Duration(delay)
+}
+
+// configures reconnection, it should be called before Listen
+func (c *CatapultWebsocketClientImpl) SetReconnectionOptions(opts *ReconnectionOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reconnection = opts.withDefaults(c.config)
+}
+
+func (c *CatapultWebsocketClientImpl) reconnectionOptions() *ReconnectionOptions {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reconnection == nil {
+		c.reconnection = (*ReconnectionOptions)(nil).withDefaults(c.config)
+	}
+
+	return c.reconnection
+}
+
+// derives stale timeout from block generation target time of the network, if neither of them is configured
+// options are left as they are if network config can't be requested
+func (c *CatapultWebsocketClientImpl) requestBlockGenerationTargetTime() {
+	opts := c.reconnectionOptions()
+	if !opts.staleBlocks || opts.BlockGenerationTargetTime != 0 {
+		return
+	}
+
+	c.mu.Lock()
+	conf := *c.config
+	c.mu.Unlock()
+
+	if conf.UsedBaseUrl == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, opts.PingInterval)
+	defer cancel()
+
+	networkConfig, err := sdk.NewClient(nil, &conf).Network.GetNetworkConfig(ctx)
+	if err != nil || networkConfig.NetworkConfig == nil {
+		return
+	}
+
+	chain, err := networkConfig.NetworkConfig.ChainConfig()
+	if err != nil || chain.BlockGenerationTargetTime <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	updated := *c.reconnection
+	updated.BlockGenerationTargetTime = chain.BlockGenerationTargetTime
+	c.reconnection = updated.withDefaults(c.config)
+}
+
+func (c *CatapultWebsocketClientImpl) setState(state ConnectionState, err error) {
+	if h := c.reconnectionOptions().StateHandler; h != nil {
+		h(state, err)
+	}
+
+	c.mu.Lock()
+	o := c.observer
+	c.mu.Unlock()
+
+	if o != nil {
+		o.ObserveConnectionState(state, err)
+	}
+}
+
+// reconnects with exponential backoff rotating base urls between attempts
+// returns the last error if all attempts failed or client is closed
+func (c *CatapultWebsocketClientImpl) reconnectWithBackoff(cause error) error {
+	opts := c.reconnectionOptions()
+
+	c.setState(Reconnecting, cause)
+
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(opts.backoff(attempt))
+
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return c.ctx.Err()
+		case <-timer.C:
+		}
+
+		err := c.reconnect()
+		if err == nil {
+			c.setState(Connected, nil)
+			return nil
+		}
+
+		if opts.MaxAttempts > 0 && attempt >= opts.MaxAttempts {
+			c.setState(Failed, err)
+			return err
+		}
+
+		c.rotateBaseUrl()
+	}
+}
+
+// makes the next of config's base urls to be dialed first
+func (c *CatapultWebsocketClientImpl) rotateBaseUrl() {
+	urls := c.config.BaseURLs
+	if len(urls) < 2 {
+		return
+	}
+
+	next := urls[0]
+	for i, u := range urls {
+		if sameUrl(u, c.config.UsedBaseUrl) {
+			next = urls[(i+1)%len(urls)]
+			break
+		}
+	}
+
+	c.config.UsedBaseUrl = next
+}
+
+func sameUrl(a, b *url.URL) bool {
+	return a != nil && b != nil && a.String() == b.String()
+}
+
+// sets read deadline, which is extended by every received message or pong, and starts pinging of passed connection
+// pinging is stopped when connection is closed
+func (c *CatapultWebsocketClientImpl) keepAlive(conn *websocket.Conn) {
+	opts := c.reconnectionOptions()
+
+	_ = conn.SetReadDeadline(time.Now().Add(opts.StaleTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(opts.StaleTimeout))
+	})
+
+	go func() {
+		ticker := time.NewTicker(opts.PingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(opts.PingInterval)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+}
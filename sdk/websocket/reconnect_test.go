@@ -0,0 +1,240 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/subscribers"
+)
+
+func TestReconnectionOptions_backoff(t *testing.T) {
+	opts := (&ReconnectionOptions{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+		Jitter:         0.1,
+	}).withDefaults(nil)
+
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{10, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		delay := opts.backoff(tt.attempt)
+		assert.True(t, delay >= tt.expected*9/10 && delay <= tt.expected*11/10, "attempt %d: %s", tt.attempt, delay)
+	}
+
+	defaults := (*ReconnectionOptions)(nil).withDefaults(&sdk.Config{WsReconnectionTimeout: 2 * time.Second})
+	assert.Equal(t, 2*time.Second, defaults.InitialBackoff)
+	assert.Equal(t, DefaultStaleBlocks*sdk.DefaultBlockGenerationTargetTime, defaults.StaleTimeout)
+
+	configured := (&ReconnectionOptions{MaxAttempts: 3}).withDefaults(&sdk.Config{
+		Websocket: &sdk.WebsocketConfig{StaleTimeout: time.Minute, MaxBackoff: 10 * time.Second, MaxAttempts: 5},
+	})
+	assert.Equal(t, time.Minute, configured.StaleTimeout)
+	assert.Equal(t, 10*time.Second, configured.MaxBackoff)
+	assert.Equal(t, 3, configured.MaxAttempts)
+	assert.Equal(t, DefaultPingInterval, configured.PingInterval)
+}
+
+const testChainConfig = `[chain]
+
+currencyMosaicId = 0x0DC6'7FBE'1CAD'29E3
+harvestingMosaicId = 0x26B6'4CBA'2E33'3C8A
+blockGenerationTargetTime = 5s
+blockTimeSmoothingFactor = 3000
+importanceGrouping = 7
+maxRollbackBlocks = 360
+maxDifficultyBlocks = 3
+maxTransactionLifetime = 24h
+maxBlockFutureTime = 10s
+maxMosaicAtomicUnits = 9'000'000'000'000'000
+totalChainImportance = 8'999'999'998'000'000
+minHarvesterBalance = 1'000'000'000'000
+harvestBeneficiaryPercentage = 10
+blockPruneInterval = 360
+maxTransactionsPerBlock = 200'000
+`
+
+func TestCatapultWebsocketClientImpl_requestBlockGenerationTargetTime(t *testing.T) {
+	networkConfig, err := json.Marshal(testChainConfig)
+	assert.Nil(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/chain/height", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"height": [10, 0]}`))
+	})
+	mux.HandleFunc("/config/10", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"networkConfig": {"height": [1, 0], "networkConfig": %s, "supportedEntityVersions": "{\"entities\": []}"}}`, networkConfig)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	baseUrl, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+
+	newClient := func() *CatapultWebsocketClientImpl {
+		return &CatapultWebsocketClientImpl{
+			config: &sdk.Config{BaseURLs: []*url.URL{baseUrl}, UsedBaseUrl: baseUrl},
+			ctx:    context.Background(),
+		}
+	}
+
+	c := newClient()
+	c.requestBlockGenerationTargetTime()
+	assert.Equal(t, 5*time.Second, c.reconnectionOptions().BlockGenerationTargetTime)
+	assert.Equal(t, DefaultStaleBlocks*5*time.Second, c.reconnectionOptions().StaleTimeout)
+
+	c = newClient()
+	c.SetReconnectionOptions(&ReconnectionOptions{StaleTimeout: time.Minute})
+	c.requestBlockGenerationTargetTime()
+	assert.Equal(t, time.Minute, c.reconnectionOptions().StaleTimeout)
+
+	server.Close()
+
+	c = newClient()
+	c.requestBlockGenerationTargetTime()
+	assert.Equal(t, DefaultStaleBlocks*sdk.DefaultBlockGenerationTargetTime, c.reconnectionOptions().StaleTimeout)
+}
+
+func TestCatapultWebsocketClientImpl_reconnectWithBackoff(t *testing.T) {
+	first, _ := url.Parse("http://first:3000")
+	second, _ := url.Parse("http://second:3000")
+
+	states := make([]ConnectionState, 0)
+	dialed := make([]string, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := &CatapultWebsocketClientImpl{
+		config: &sdk.Config{BaseURLs: []*url.URL{first, second}, UsedBaseUrl: first},
+		ctx:    ctx,
+		connectFn: func(cfg *sdk.Config) (*websocket.Conn, string, error) {
+			dialed = append(dialed, cfg.UsedBaseUrl.Hostname())
+			return nil, "", errors.New("test error")
+		},
+	}
+
+	c.SetReconnectionOptions(&ReconnectionOptions{
+		InitialBackoff: time.Millisecond,
+		MaxAttempts:    3,
+		StateHandler: func(state ConnectionState, _ error) {
+			states = append(states, state)
+		},
+	})
+
+	assert.NotNil(t, c.reconnectWithBackoff(errors.New("connection is lost")))
+	assert.Equal(t, []ConnectionState{Reconnecting, Failed}, states)
+	assert.Equal(t, []string{"first", "second", "first"}, dialed)
+}
+
+func TestCatapultWebsocketClientImpl_Listen_StaleConnection(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+
+	var (
+		lock        sync.Mutex
+		connections int
+	)
+
+	// server sends uid and never answers, so connection becomes stale
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		lock.Lock()
+		connections++
+		lock.Unlock()
+
+		_ = conn.WriteJSON(&wsConnectionResponse{Uid: "test-uid"})
+
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	baseUrl, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+
+	cfg := &sdk.Config{BaseURLs: []*url.URL{baseUrl}, UsedBaseUrl: baseUrl}
+
+	conn, uid, err := connect(cfg)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	messagePublisher := newMessagePublisher(conn)
+
+	c := &CatapultWebsocketClientImpl{
+		config:                        cfg,
+		conn:                          conn,
+		ctx:                           ctx,
+		cancelFunc:                    cancel,
+		UID:                           uid,
+		connectFn:                     connect,
+		blockSubscriber:               subscribers.NewBlock(),
+		confirmedAddedSubscribers:     subscribers.NewConfirmedAdded(),
+		unconfirmedAddedSubscribers:   subscribers.NewUnconfirmedAdded(),
+		unconfirmedRemovedSubscribers: subscribers.NewUnconfirmedRemoved(),
+		partialAddedSubscribers:       subscribers.NewPartialAdded(),
+		partialRemovedSubscribers:     subscribers.NewPartialRemoved(),
+		statusSubscribers:             subscribers.NewStatus(),
+		cosignatureSubscribers:        subscribers.NewCosignature(),
+		messagePublisher:              messagePublisher,
+		messageRouter:                 NewRouter(uid, messagePublisher, make(topicHandlers)),
+	}
+
+	states := make(chan ConnectionState, 10)
+	c.SetReconnectionOptions(&ReconnectionOptions{
+		PingInterval:   time.Hour,
+		StaleTimeout:   50 * time.Millisecond,
+		InitialBackoff: time.Millisecond,
+		StateHandler: func(state ConnectionState, _ error) {
+			states <- state
+		},
+	})
+
+	go c.Listen()
+
+	for _, expected := range []ConnectionState{Reconnecting, Connected} {
+		select {
+		case state := <-states:
+			assert.Equal(t, expected, state)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s state is not reported", expected)
+		}
+	}
+
+	assert.Nil(t, c.Close())
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.True(t, connections >= 2)
+}
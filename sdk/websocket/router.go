@@ -3,6 +3,7 @@ package websocket
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -25,6 +26,7 @@ type Router interface {
 }
 
 type messageRouter struct {
+	sync.RWMutex
 	uid               string
 	messagePublisher  MessagePublisher
 	messageInfoMapper MessageInfoMapper
@@ -43,7 +45,11 @@ func (r *messageRouter) RouteMessage(m []byte) {
 	}
 
 	if ok := handler.Handle(messageInfo.Address, m); !ok {
-		if err := r.messagePublisher.PublishUnsubscribeMessage(r.uid, Path(handler.Format(messageInfo))); err != nil {
+		r.RLock()
+		uid := r.uid
+		r.RUnlock()
+
+		if err := r.messagePublisher.PublishUnsubscribeMessage(uid, Path(handler.Format(messageInfo))); err != nil {
 			panic(errors.Wrap(err, "unsubscribing from topic"))
 		}
 	}
@@ -52,6 +58,9 @@ func (r *messageRouter) RouteMessage(m []byte) {
 }
 
 func (r *messageRouter) SetUid(uid string) {
+	r.Lock()
+	defer r.Unlock()
+
 	r.uid = uid
 }
 
@@ -105,6 +114,37 @@ func (h topicHandlers) SetTopicHandler(path Path, handler *TopicHandler) {
 	h[path] = handler
 }
 
+// syncTopicHandlers makes storage safe for concurrent use
+type syncTopicHandlers struct {
+	sync.RWMutex
+	storage TopicHandlersStorage
+}
+
+func newSyncTopicHandlers(storage TopicHandlersStorage) TopicHandlersStorage {
+	return &syncTopicHandlers{storage: storage}
+}
+
+func (h *syncTopicHandlers) HasHandler(path Path) bool {
+	h.RLock()
+	defer h.RUnlock()
+
+	return h.storage.HasHandler(path)
+}
+
+func (h *syncTopicHandlers) GetHandler(path Path) *TopicHandler {
+	h.RLock()
+	defer h.RUnlock()
+
+	return h.storage.GetHandler(path)
+}
+
+func (h *syncTopicHandlers) SetTopicHandler(path Path, handler *TopicHandler) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.storage.SetTopicHandler(path, handler)
+}
+
 type Topic interface {
 	Format(info *sdk.WsMessageInfo) Path
 }
@@ -0,0 +1,426 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/subscribers"
+)
+
+const (
+	DefaultAddressesPerConnection = 500
+	DefaultShardBufferSize        = 1024
+	DefaultShardDedupSize         = 10000
+	DefaultShardReconnectAttempts = 5
+)
+
+var (
+	ErrShardedClientClosed = errors.New("sharded websocket client is closed")
+	ErrNoShardCapacity     = errors.New("all connections are full and connections limit is reached")
+)
+
+// ShardedOptions configures ShardedClient
+// `Replicas` is the number of connections subscribed to every address, transactions received by several of them are delivered once
+// `MaxConnections` is unlimited if zero
+// `ReconnectAttempts` is the number of reconnection attempts, after which addresses of connection are moved to other ones
+// `ErrorHandler` is called when addresses can't be moved from failed connection
+// `NewClient` is used to create connections, NewClient of this package is used if nil
+type ShardedOptions struct {
+	AddressesPerConnection int
+	Replicas               int
+	MaxConnections         int
+	BufferSize             int
+	DedupSize              int
+	ReconnectAttempts      int
+	ErrorHandler           func(error)
+	NewClient              func(ctx context.Context, cfg *sdk.Config) (CatapultClient, error)
+}
+
+// returns options with defaults instead of zero values
+func (o *ShardedOptions) withDefaults() *ShardedOptions {
+	opts := ShardedOptions{}
+	if o != nil {
+		opts = *o
+	}
+
+	if opts.AddressesPerConnection <= 0 {
+		opts.AddressesPerConnection = DefaultAddressesPerConnection
+	}
+
+	if opts.Replicas <= 0 {
+		opts.Replicas = 1
+	}
+
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = DefaultShardBufferSize
+	}
+
+	if opts.DedupSize <= 0 {
+		opts.DedupSize = DefaultShardDedupSize
+	}
+
+	if opts.ReconnectAttempts <= 0 {
+		opts.ReconnectAttempts = DefaultShardReconnectAttempts
+	}
+
+	if opts.NewClient == nil {
+		opts.NewClient = NewClient
+	}
+
+	return &opts
+}
+
+// AddressTransaction is confirmed transaction received by ShardedClient for watched address
+type AddressTransaction struct {
+	Address     *sdk.Address
+	Transaction sdk.Transaction
+}
+
+// ShardedClient watches confirmed transactions of many addresses
+// addresses are spread across multiple connections to config's base urls, transactions of all of them are fanned into one channel
+type ShardedClient struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	config *sdk.Config
+	opts   *ShardedOptions
+	out    chan *AddressTransaction
+
+	mu        sync.Mutex
+	shards    []*shard
+	addresses map[string]*sdk.Address
+	nextNode  int
+	closed    bool
+
+	// guards sending into out channel, so it is closed only after all senders are gone
+	outLock sync.RWMutex
+	seen    *recentSet
+}
+
+type shard struct {
+	client   CatapultClient
+	handlers map[string][]subscribers.ConfirmedAddedHandler
+	failed   bool
+}
+
+// returns ShardedClient, connections are established when addresses are subscribed
+func NewShardedClient(ctx context.Context, cfg *sdk.Config, opts *ShardedOptions) (*ShardedClient, error) {
+	if cfg == nil || len(cfg.BaseURLs) == 0 {
+		return nil, errors.New("empty base urls")
+	}
+
+	opts = opts.withDefaults()
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &ShardedClient{
+		ctx:       ctx,
+		cancel:    cancel,
+		config:    cfg,
+		opts:      opts,
+		out:       make(chan *AddressTransaction, opts.BufferSize),
+		addresses: make(map[string]*sdk.Address),
+		seen:      newRecentSet(opts.DedupSize),
+	}, nil
+}
+
+// returns channel of confirmed transactions of all subscribed addresses, it is closed by Close
+func (s *ShardedClient) Transactions() <-chan *AddressTransaction {
+	return s.out
+}
+
+// returns number of live connections
+func (s *ShardedClient) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.shards)
+}
+
+// subscribes confirmed transactions of passed addresses, already subscribed addresses are skipped
+func (s *ShardedClient) Subscribe(addresses ...*sdk.Address) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrShardedClientClosed
+	}
+
+	for _, address := range addresses {
+		if address == nil {
+			return sdk.ErrNilAddress
+		}
+
+		if _, ok := s.addresses[address.Address]; ok {
+			continue
+		}
+
+		for i := 0; i < s.opts.Replicas; i++ {
+			if err := s.assign(address); err != nil {
+				// address is not remembered, so it is subscribed again by the next call
+				_ = s.unassign(address)
+				s.closeEmptyShards()
+
+				return err
+			}
+		}
+
+		s.addresses[address.Address] = address
+	}
+
+	return nil
+}
+
+// unsubscribes passed addresses on all connections, connections without addresses are closed
+func (s *ShardedClient) Unsubscribe(addresses ...*sdk.Address) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrShardedClientClosed
+	}
+
+	for _, address := range addresses {
+		if address == nil {
+			return sdk.ErrNilAddress
+		}
+
+		delete(s.addresses, address.Address)
+
+		if err := s.unassign(address); err != nil {
+			return err
+		}
+	}
+
+	s.closeEmptyShards()
+
+	return nil
+}
+
+// closes all connections and channel of transactions
+func (s *ShardedClient) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.closed = true
+	shards := s.shards
+	s.shards = nil
+	s.mu.Unlock()
+
+	s.cancel()
+
+	var err error
+	for _, sh := range shards {
+		if e := sh.client.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	s.outLock.Lock()
+	close(s.out)
+	s.outLock.Unlock()
+
+	return err
+}
+
+// subscribes address on the least loaded connection, which doesn't have it yet, new connection is created if there is no such
+func (s *ShardedClient) assign(address *sdk.Address) error {
+	var target *shard
+
+	for _, sh := range s.shards {
+		if _, ok := sh.handlers[address.Address]; ok || len(sh.handlers) >= s.opts.AddressesPerConnection {
+			continue
+		}
+
+		if target == nil || len(sh.handlers) < len(target.handlers) {
+			target = sh
+		}
+	}
+
+	if target == nil {
+		sh, err := s.newShard()
+		if err != nil {
+			return err
+		}
+
+		target = sh
+	}
+
+	handlers := []subscribers.ConfirmedAddedHandler{func(tx sdk.Transaction) bool {
+		s.publish(address, tx)
+		return false
+	}}
+
+	if err := target.client.AddConfirmedAddedHandlers(address, handlers...); err != nil {
+		return err
+	}
+
+	target.handlers[address.Address] = handlers
+
+	return nil
+}
+
+// connects to the next of config's base urls
+func (s *ShardedClient) newShard() (*shard, error) {
+	if s.opts.MaxConnections > 0 && len(s.shards) >= s.opts.MaxConnections {
+		return nil, ErrNoShardCapacity
+	}
+
+	cfg := *s.config
+	cfg.UsedBaseUrl = cfg.BaseURLs[s.nextNode%len(cfg.BaseURLs)]
+	s.nextNode++
+
+	client, err := s.opts.NewClient(s.ctx, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sh := &shard{
+		client:   client,
+		handlers: make(map[string][]subscribers.ConfirmedAddedHandler),
+	}
+
+	client.SetReconnectionOptions(&ReconnectionOptions{
+		MaxAttempts: s.opts.ReconnectAttempts,
+		StateHandler: func(state ConnectionState, _ error) {
+			if state == Failed {
+				go s.rebalance(sh)
+			}
+		},
+	})
+
+	go client.Listen()
+
+	s.shards = append(s.shards, sh)
+
+	return sh, nil
+}
+
+// moves addresses of failed connection to other ones
+func (s *ShardedClient) rebalance(failed *shard) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed || failed.failed {
+		return
+	}
+
+	failed.failed = true
+	_ = failed.client.Close()
+
+	for i, sh := range s.shards {
+		if sh == failed {
+			s.shards = append(s.shards[:i], s.shards[i+1:]...)
+			break
+		}
+	}
+
+	for key := range failed.handlers {
+		address, ok := s.addresses[key]
+		if !ok {
+			continue
+		}
+
+		if err := s.assign(address); err != nil && s.opts.ErrorHandler != nil {
+			s.opts.ErrorHandler(errors.Wrapf(err, "moving address %s to another connection", key))
+		}
+	}
+}
+
+// unsubscribes address on all connections, which have it
+func (s *ShardedClient) unassign(address *sdk.Address) error {
+	for _, sh := range s.shards {
+		handlers, ok := sh.handlers[address.Address]
+		if !ok {
+			continue
+		}
+
+		delete(sh.handlers, address.Address)
+
+		if err := sh.client.RemoveConfirmedAddedHandlers(address, &handlers[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ShardedClient) closeEmptyShards() {
+	shards := s.shards[:0]
+
+	for _, sh := range s.shards {
+		if len(sh.handlers) > 0 {
+			shards = append(shards, sh)
+			continue
+		}
+
+		_ = sh.client.Close()
+	}
+
+	s.shards = shards
+}
+
+// sends transaction into out channel if it is not received yet by another connection
+func (s *ShardedClient) publish(address *sdk.Address, tx sdk.Transaction) {
+	if info := tx.GetAbstractTransaction().TransactionInfo; info != nil && info.TransactionHash != nil {
+		if !s.seen.add(address.Address + info.TransactionHash.String()) {
+			return
+		}
+	}
+
+	s.outLock.RLock()
+	defer s.outLock.RUnlock()
+
+	// channel is closed after context is cancelled
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	select {
+	case s.out <- &AddressTransaction{Address: address, Transaction: tx}:
+	case <-s.ctx.Done():
+	}
+}
+
+// recentSet remembers limited number of the most recently added keys
+type recentSet struct {
+	sync.Mutex
+	keys map[string]struct{}
+	ring []string
+	next int
+}
+
+func newRecentSet(size int) *recentSet {
+	return &recentSet{
+		keys: make(map[string]struct{}, size),
+		ring: make([]string, size),
+	}
+}
+
+// returns false if key is already in the set
+func (s *recentSet) add(key string) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.keys[key]; ok {
+		return false
+	}
+
+	if old := s.ring[s.next]; old != "" {
+		delete(s.keys, old)
+	}
+
+	s.ring[s.next] = key
+	s.keys[key] = struct{}{}
+	s.next = (s.next + 1) % len(s.ring)
+
+	return true
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 ProximaX Limited. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk"
+	"github.com/bilaxy-exchange/go-xpx-chain-sdk/sdk/websocket/subscribers"
+)
+
+// testShardClient implements only methods used by ShardedClient
+type testShardClient struct {
+	CatapultClient
+
+	sync.Mutex
+	node         string
+	handlers     map[string]subscribers.ConfirmedAddedHandler
+	stateHandler StateHandler
+	closed       bool
+}
+
+func (c *testShardClient) AddConfirmedAddedHandlers(address *sdk.Address, handlers ...subscribers.ConfirmedAddedHandler) error {
+	c.Lock()
+	defer c.Unlock()
+
+	c.handlers[address.Address] = handlers[0]
+	return nil
+}
+
+func (c *testShardClient) RemoveConfirmedAddedHandlers(address *sdk.Address, _ ...*subscribers.ConfirmedAddedHandler) error {
+	c.Lock()
+	defer c.Unlock()
+
+	delete(c.handlers, address.Address)
+	return nil
+}
+
+func (c *testShardClient) SetReconnectionOptions(opts *ReconnectionOptions) {
+	c.stateHandler = opts.StateHandler
+}
+
+func (c *testShardClient) Listen() {}
+
+func (c *testShardClient) Close() error {
+	c.Lock()
+	defer c.Unlock()
+
+	c.closed = true
+	return nil
+}
+
+func (c *testShardClient) handler(address *sdk.Address) subscribers.ConfirmedAddedHandler {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.handlers[address.Address]
+}
+
+func TestShardedClient(t *testing.T) {
+	first, _ := url.Parse("http://first:3000")
+	second, _ := url.Parse("http://second:3000")
+
+	clients := make([]*testShardClient, 0)
+
+	s, err := NewShardedClient(context.Background(), &sdk.Config{BaseURLs: []*url.URL{first, second}}, &ShardedOptions{
+		AddressesPerConnection: 2,
+		Replicas:               2,
+		NewClient: func(_ context.Context, cfg *sdk.Config) (CatapultClient, error) {
+			c := &testShardClient{node: cfg.UsedBaseUrl.Hostname(), handlers: make(map[string]subscribers.ConfirmedAddedHandler)}
+			clients = append(clients, c)
+			return c, nil
+		},
+	})
+	assert.Nil(t, err)
+
+	a1, a2, a3 := &sdk.Address{Address: "address-1"}, &sdk.Address{Address: "address-2"}, &sdk.Address{Address: "address-3"}
+
+	assert.Nil(t, s.Subscribe(a1, a2, a3, a1))
+	assert.Equal(t, 4, s.Connections())
+	assert.Equal(t, "first", clients[0].node)
+	assert.Equal(t, "second", clients[1].node)
+	assert.Len(t, clients[0].handlers, 2)
+	assert.Len(t, clients[1].handlers, 2)
+
+	// the same transaction is received by both replicas
+	tx := newTestTransferTransaction(10, sdk.Hash{1})
+	assert.False(t, clients[0].handler(a1)(tx))
+	assert.False(t, clients[1].handler(a1)(tx))
+
+	received := <-s.Transactions()
+	assert.Equal(t, a1, received.Address)
+	assert.Equal(t, tx, received.Transaction)
+	assert.Empty(t, s.Transactions())
+
+	// addresses of failed connection are moved to other ones
+	clients[0].stateHandler(Failed, errors.New("test error"))
+
+	for i := 0; i < 100 && s.Connections() != 3; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.Equal(t, 3, s.Connections())
+	assert.True(t, clients[0].closed)
+
+	// order of moving addresses is not defined, so every one of them is looked up in the rest connections
+	for _, address := range []*sdk.Address{a1, a2} {
+		replicas := 0
+		for _, c := range clients[1:] {
+			if !c.closed && c.handler(address) != nil {
+				replicas++
+			}
+		}
+
+		assert.Equal(t, 2, replicas, address.Address)
+	}
+
+	assert.Nil(t, s.Unsubscribe(a1, a2, a3))
+	assert.Equal(t, 0, s.Connections())
+
+	assert.Nil(t, s.Close())
+	_, ok := <-s.Transactions()
+	assert.False(t, ok)
+	assert.Equal(t, ErrShardedClientClosed, s.Subscribe(a1))
+}
+
+func TestShardedClient_SubscribeFailedReplica(t *testing.T) {
+	first, _ := url.Parse("http://first:3000")
+
+	clients := make([]*testShardClient, 0)
+
+	s, err := NewShardedClient(context.Background(), &sdk.Config{BaseURLs: []*url.URL{first}}, &ShardedOptions{
+		AddressesPerConnection: 2,
+		Replicas:               2,
+		MaxConnections:         1,
+		NewClient: func(_ context.Context, cfg *sdk.Config) (CatapultClient, error) {
+			c := &testShardClient{node: cfg.UsedBaseUrl.Hostname(), handlers: make(map[string]subscribers.ConfirmedAddedHandler)}
+			clients = append(clients, c)
+			return c, nil
+		},
+	})
+	assert.Nil(t, err)
+
+	a1 := &sdk.Address{Address: "address-1"}
+
+	// the second replica needs a connection over the limit
+	assert.Equal(t, ErrNoShardCapacity, s.Subscribe(a1))
+	assert.Equal(t, 0, s.Connections())
+	assert.Nil(t, clients[0].handler(a1))
+	assert.True(t, clients[0].closed)
+
+	// address is not remembered, so it is subscribed again
+	assert.Equal(t, ErrNoShardCapacity, s.Subscribe(a1))
+
+	assert.Nil(t, s.Close())
+}
@@ -74,5 +74,14 @@ func (s *blockSubscriberImpl) GetHandlers() map[*BlockHandler]struct{} {
 	s.RLock()
 	defer s.RUnlock()
 
-	return s.handlers
+	if s.handlers == nil {
+		return nil
+	}
+
+	handlers := make(map[*BlockHandler]struct{}, len(s.handlers))
+	for h := range s.handlers {
+		handlers[h] = struct{}{}
+	}
+
+	return handlers
 }
@@ -70,6 +70,8 @@ func (e *confirmedAddedImpl) RemoveHandlers(address *sdk.Address, handlers ...*C
 		return false, nil
 	}
 
+	delete(e.subscribers, address.Address)
+
 	return true, nil
 }
 
@@ -89,13 +91,21 @@ func (e *confirmedAddedImpl) GetHandlers(address *sdk.Address) map[*ConfirmedAdd
 	defer e.RUnlock()
 
 	if res, ok := e.subscribers[address.Address]; ok && res != nil {
-		return res
+		handlers := make(map[*ConfirmedAddedHandler]struct{}, len(res))
+		for h := range res {
+			handlers[h] = struct{}{}
+		}
+
+		return handlers
 	}
 
 	return nil
 }
 
 func (e *confirmedAddedImpl) GetAddresses() []string {
+	e.RLock()
+	defer e.RUnlock()
+
 	addresses := make([]string, 0, len(e.subscribers))
 	for addr := range e.subscribers {
 		addresses = append(addresses, addr)
@@ -71,6 +71,8 @@ func (e *partialAddedImpl) RemoveHandlers(address *sdk.Address, handlers ...*Par
 		return false, nil
 	}
 
+	delete(e.subscribers, address.Address)
+
 	return true, nil
 }
 
@@ -90,13 +92,21 @@ func (e *partialAddedImpl) GetHandlers(address *sdk.Address) map[*PartialAddedHa
 	defer e.RUnlock()
 
 	if res, ok := e.subscribers[address.Address]; ok && res != nil {
-		return res
+		handlers := make(map[*PartialAddedHandler]struct{}, len(res))
+		for h := range res {
+			handlers[h] = struct{}{}
+		}
+
+		return handlers
 	}
 
 	return nil
 }
 
 func (e *partialAddedImpl) GetAddresses() []string {
+	e.RLock()
+	defer e.RUnlock()
+
 	addresses := make([]string, 0, len(e.subscribers))
 	for addr := range e.subscribers {
 		addresses = append(addresses, addr)
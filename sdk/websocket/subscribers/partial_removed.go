@@ -71,6 +71,8 @@ func (e *partialRemovedImpl) RemoveHandlers(address *sdk.Address, handlers ...*P
 		return false, nil
 	}
 
+	delete(e.subscribers, address.Address)
+
 	return true, nil
 }
 
@@ -90,13 +92,21 @@ func (e *partialRemovedImpl) GetHandlers(address *sdk.Address) map[*PartialRemov
 	defer e.RUnlock()
 
 	if res, ok := e.subscribers[address.Address]; ok && res != nil {
-		return res
+		handlers := make(map[*PartialRemovedHandler]struct{}, len(res))
+		for h := range res {
+			handlers[h] = struct{}{}
+		}
+
+		return handlers
 	}
 
 	return nil
 }
 
 func (e *partialRemovedImpl) GetAddresses() []string {
+	e.RLock()
+	defer e.RUnlock()
+
 	addresses := make([]string, 0, len(e.subscribers))
 	for addr := range e.subscribers {
 		addresses = append(addresses, addr)
@@ -70,6 +70,8 @@ func (e *statusImpl) RemoveHandlers(address *sdk.Address, handlers ...*StatusHan
 		return false, nil
 	}
 
+	delete(e.subscribers, address.Address)
+
 	return true, nil
 }
 
@@ -89,13 +91,21 @@ func (e *statusImpl) GetHandlers(address *sdk.Address) map[*StatusHandler]struct
 	defer e.Unlock()
 
 	if res, ok := e.subscribers[address.Address]; ok && res != nil {
-		return res
+		handlers := make(map[*StatusHandler]struct{}, len(res))
+		for h := range res {
+			handlers[h] = struct{}{}
+		}
+
+		return handlers
 	}
 
 	return nil
 }
 
 func (e *statusImpl) GetAddresses() []string {
+	e.RLock()
+	defer e.RUnlock()
+
 	addresses := make([]string, 0, len(e.subscribers))
 	for addr := range e.subscribers {
 		addresses = append(addresses, addr)
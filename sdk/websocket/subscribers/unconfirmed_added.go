@@ -70,6 +70,8 @@ func (e *unconfirmedAddedImpl) RemoveHandlers(address *sdk.Address, handlers ...
 		return false, nil
 	}
 
+	delete(e.subscribers, address.Address)
+
 	return true, nil
 }
 
@@ -89,13 +91,21 @@ func (e *unconfirmedAddedImpl) GetHandlers(address *sdk.Address) map[*Unconfirme
 	defer e.RUnlock()
 
 	if res, ok := e.subscribers[address.Address]; ok && res != nil {
-		return res
+		handlers := make(map[*UnconfirmedAddedHandler]struct{}, len(res))
+		for h := range res {
+			handlers[h] = struct{}{}
+		}
+
+		return handlers
 	}
 
 	return nil
 }
 
 func (e *unconfirmedAddedImpl) GetAddresses() []string {
+	e.RLock()
+	defer e.RUnlock()
+
 	addresses := make([]string, 0, len(e.subscribers))
 	for addr := range e.subscribers {
 		addresses = append(addresses, addr)